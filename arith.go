@@ -0,0 +1,162 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package csirac
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// A word holds a 20-bit two's complement value. Read as an integer, it lies
+// in [-2^19, 2^19). Read as a fraction, the binary point is just below the
+// sign digit (p20), so it lies in [-1, 1) in steps of 2^-19.
+const (
+	// MinInt and MaxInt are the smallest and largest integers in a word.
+	MinInt = -1 << 19
+	MaxInt = 1<<19 - 1
+
+	// fracBits is the number of digits after the binary point in a fraction.
+	fracBits = 19
+
+	// pow5Frac is 5^19, used to write 2^-19 exactly as a decimal.
+	pow5Frac = 19073486328125
+)
+
+// Int returns the word as a signed integer.
+func (w Word) Int() int {
+	w &= allBits
+	if w&signBit != 0 {
+		return int(w) - 1<<20
+	}
+	return int(w)
+}
+
+// Frac returns the word as a signed fraction in [-1, 1). The conversion is
+// exact.
+func (w Word) Frac() float64 { return math.Ldexp(float64(w.Int()), -fracBits) }
+
+// Rat returns the word as a signed fraction in [-1, 1), as an exact rational.
+func (w Word) Rat() *big.Rat { return big.NewRat(int64(w.Int()), 1<<fracBits) }
+
+// IntString formats the word as a signed decimal integer.
+func (w Word) IntString() string { return strconv.Itoa(w.Int()) }
+
+// FracString formats the word as a signed decimal fraction. Every fraction
+// has a finite decimal expansion (of at most 19 places), so the result is
+// exact. Trailing zeroes are trimmed, but there is always at least one digit
+// after the point: for example, "0.5", "-0.25", "-1.0".
+func (w Word) FracString() string {
+	n := w.Int()
+	sign := ""
+	if n < 0 {
+		sign, n = "-", -n
+	}
+	// n/2^19 = n*5^19/10^19, and n*5^19 <= 10^19 fits in a uint64.
+	digits := strconv.FormatUint(uint64(n)*pow5Frac, 10)
+	if len(digits) <= fracBits {
+		digits = strings.Repeat("0", fracBits+1-len(digits)) + digits
+	}
+	ip, fp := digits[:len(digits)-fracBits], strings.TrimRight(digits[len(digits)-fracBits:], "0")
+	if fp == "" {
+		fp = "0"
+	}
+	return sign + ip + "." + fp
+}
+
+// FracWord returns the word nearest to the fraction f. Values outside [-1, 1)
+// are clamped to the nearest fraction in range, and NaN becomes 0.
+func FracWord(f float64) Word {
+	if math.IsNaN(f) {
+		return 0
+	}
+	return clampFrac(math.RoundToEven(math.Ldexp(f, fracBits)))
+}
+
+// RatWord returns the word nearest to the fraction r, rounding halves to
+// even. Values outside [-1, 1) are clamped to the nearest fraction in range.
+func RatWord(r *big.Rat) Word {
+	// Scale by 2^19 and round to the nearest integer.
+	s := new(big.Rat).Mul(r, new(big.Rat).SetInt64(1<<fracBits))
+	q, m := new(big.Int).QuoRem(s.Num(), s.Denom(), new(big.Int))
+	// QuoRem truncates towards zero; adjust q if the remainder is more than
+	// half (or exactly half, and q is odd).
+	m.Abs(m).Lsh(m, 1)
+	if c := m.Cmp(s.Denom()); c > 0 || (c == 0 && q.Bit(0) == 1) {
+		if s.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	if !q.IsInt64() {
+		return clampFrac(float64(q.Sign()) * math.Inf(1))
+	}
+	return clampFrac(float64(q.Int64()))
+}
+
+// clampFrac converts a fraction scaled by 2^19 to a word, clamping it to
+// the range of a word.
+func clampFrac(x float64) Word {
+	switch {
+	case x < MinInt:
+		x = MinInt
+	case x > MaxInt:
+		x = MaxInt
+	}
+	return IntWord(int(x))
+}
+
+// Product is a double-length value held in A and B after multiplication (the
+// XB destination). The upper 20 digits (including the sign) are in A, and the
+// lower 19 digits are in p2-p20 of B (p1 of B is ignored). Taken together
+// this is a 39-bit two's complement value, whose meaning depends on what was
+// multiplied.
+//
+// XB multiplies the parts of its operands below the sign digit, so these
+// interpretations give the true product when the operands are non-negative
+// (and A was cleared beforehand). Programs typically multiply magnitudes and
+// fix up the sign afterwards.
+type Product struct {
+	A, B Word
+}
+
+// Raw returns the 39-bit two's complement value of A:B.
+func (p Product) Raw() int64 {
+	return int64(p.A.Int())<<fracBits | int64(p.B&allBits>>1)
+}
+
+// Int interprets the product of two integers. The result is an integer.
+func (p Product) Int() int64 { return p.Raw() }
+
+// Frac interprets the product of two fractions. The result is a fraction in
+// [-1, 1) with 38 places after the binary point: A holds the fraction to 19
+// places, and B extends it.
+func (p Product) Frac() float64 { return math.Ldexp(float64(p.Raw()), -2*fracBits) }
+
+// FracRat is the exact rational form of Frac.
+func (p Product) FracRat() *big.Rat {
+	return new(big.Rat).SetFrac(big.NewInt(p.Raw()), new(big.Int).Lsh(big.NewInt(1), 2*fracBits))
+}
+
+// Mixed interprets the product of an integer and a fraction. The integer part
+// is in A, and the fraction part is in B.
+func (p Product) Mixed() float64 { return math.Ldexp(float64(p.Raw()), -fracBits) }
+
+// MixedRat is the exact rational form of Mixed.
+func (p Product) MixedRat() *big.Rat { return big.NewRat(p.Raw(), 1<<fracBits) }
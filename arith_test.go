@@ -0,0 +1,146 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package csirac
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestWordNumbers(t *testing.T) {
+	tests := []struct {
+		w       Word
+		i       int
+		f       float64
+		fracStr string
+	}{
+		{w: 0, i: 0, f: 0, fracStr: "0.0"},
+		{w: 1, i: 1, f: 0x1p-19, fracStr: "0.0000019073486328125"},
+		{w: 0b01000_00000_00000_00000, i: 1 << 18, f: 0.5, fracStr: "0.5"},
+		{w: 0b01111_11111_11111_11111, i: MaxInt, f: 1 - 0x1p-19, fracStr: "0.9999980926513671875"},
+		{w: 0b10000_00000_00000_00000, i: MinInt, f: -1, fracStr: "-1.0"},
+		{w: 0b11000_00000_00000_00000, i: -1 << 18, f: -0.5, fracStr: "-0.5"},
+		{w: 0b11110_00000_00000_00000, i: -1 << 16, f: -0.125, fracStr: "-0.125"},
+		{w: 0b11111_11111_11111_11111, i: -1, f: -0x1p-19, fracStr: "-0.0000019073486328125"},
+	}
+	for _, test := range tests {
+		if got := test.w.Int(); got != test.i {
+			t.Errorf("%v.Int() = %d, want %d", test.w, got, test.i)
+		}
+		if got := IntWord(test.i); got != test.w {
+			t.Errorf("IntWord(%d) = %v, want %v", test.i, got, test.w)
+		}
+		if got := test.w.Frac(); got != test.f {
+			t.Errorf("%v.Frac() = %v, want %v", test.w, got, test.f)
+		}
+		if got := FracWord(test.f); got != test.w {
+			t.Errorf("FracWord(%v) = %v, want %v", test.f, got, test.w)
+		}
+		if got, want := test.w.Rat(), new(big.Rat).SetFloat64(test.f); got.Cmp(want) != 0 {
+			t.Errorf("%v.Rat() = %v, want %v", test.w, got, want)
+		}
+		if got := RatWord(test.w.Rat()); got != test.w {
+			t.Errorf("RatWord(%v) = %v, want %v", test.w.Rat(), got, test.w)
+		}
+		if got := test.w.FracString(); got != test.fracStr {
+			t.Errorf("%v.FracString() = %q, want %q", test.w, got, test.fracStr)
+		}
+	}
+}
+
+func TestIntString(t *testing.T) {
+	for _, i := range []int{0, 1, -1, 42, -42, MaxInt, MinInt} {
+		if got, want := IntWord(i).IntString(), big.NewInt(int64(i)).String(); got != want {
+			t.Errorf("IntWord(%d).IntString() = %q, want %q", i, got, want)
+		}
+	}
+}
+
+func TestFracWordRounding(t *testing.T) {
+	tests := []struct {
+		f    float64
+		r    *big.Rat
+		want Word
+	}{
+		{f: 0.1, r: big.NewRat(1, 10), want: 52429}, // 0.1 * 2^19 = 52428.8
+		{f: -0.1, r: big.NewRat(-1, 10), want: IntWord(-52429)},
+		{f: 1.5 * 0x1p-19, r: big.NewRat(3, 1<<20), want: 2},                    // half rounds to even
+		{f: 2.5 * 0x1p-19, r: big.NewRat(5, 1<<20), want: 2},                    // half rounds to even
+		{f: -2.5 * 0x1p-19, r: big.NewRat(-5, 1<<20), want: IntWord(-2)},        // half rounds to even
+		{f: 1, r: big.NewRat(1, 1), want: MaxInt},                               // clamped
+		{f: -3, r: big.NewRat(-3, 1), want: IntWord(MinInt)},                    // clamped
+		{f: math.Inf(1), r: new(big.Rat).SetInt64(math.MaxInt64), want: MaxInt}, // clamped
+	}
+	for _, test := range tests {
+		if got := FracWord(test.f); got != test.want {
+			t.Errorf("FracWord(%v) = %v, want %v", test.f, got, test.want)
+		}
+		if got := RatWord(test.r); got != test.want {
+			t.Errorf("RatWord(%v) = %v, want %v", test.r, got, test.want)
+		}
+	}
+	if got := FracWord(math.NaN()); got != 0 {
+		t.Errorf("FracWord(NaN) = %v, want 0", got)
+	}
+}
+
+func TestProduct(t *testing.T) {
+	// mul multiplies x and y with XB, as a program would.
+	mul := func(x, y Word) Product {
+		c := &CSIRAC{C: y}
+		c.WriteDest(12, x)
+		return Product{A: c.A, B: c.B}
+	}
+
+	// Integers: 1000 * 3000 = 3000000 doesn't fit in 19 bits, but does in
+	// the double-length product.
+	if got, want := mul(1000, 3000).Int(), int64(3000000); got != want {
+		t.Errorf("mul(1000, 3000).Int() = %d, want %d", got, want)
+	}
+
+	// Fractions: 0.75 * 0.5 = 0.375, and the lower digits go in B.
+	p := mul(FracWord(0.75), FracWord(0.5))
+	if got, want := p.Frac(), 0.375; got != want {
+		t.Errorf("mul(0.75, 0.5).Frac() = %v, want %v", got, want)
+	}
+	if got, want := p.A.Frac(), 0.375; got != want {
+		t.Errorf("mul(0.75, 0.5).A.Frac() = %v, want %v", got, want)
+	}
+	p = mul(1, 1) // 2^-19 * 2^-19
+	if got, want := p.FracRat(), big.NewRat(1, 1<<38); got.Cmp(want) != 0 {
+		t.Errorf("mul(2^-19, 2^-19).FracRat() = %v, want %v", got, want)
+	}
+
+	// Integer times fraction: 7 * 0.5 = 3.5, with 3 in A and 0.5 in B.
+	p = mul(7, FracWord(0.5))
+	if got, want := p.Mixed(), 3.5; got != want {
+		t.Errorf("mul(7, 0.5).Mixed() = %v, want %v", got, want)
+	}
+	if got, want := p.MixedRat(), big.NewRat(7, 2); got.Cmp(want) != 0 {
+		t.Errorf("mul(7, 0.5).MixedRat() = %v, want %v", got, want)
+	}
+	if got, want := p.A.Int(), 3; got != want {
+		t.Errorf("mul(7, 0.5).A.Int() = %d, want %d", got, want)
+	}
+
+	// A negative double-length value.
+	p = Product{A: IntWord(-1), B: 0}
+	if got, want := p.Int(), int64(-1<<19); got != want {
+		t.Errorf("Product{-1, 0}.Int() = %d, want %d", got, want)
+	}
+}
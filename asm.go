@@ -0,0 +1,408 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package csirac
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Program is an assembled program.
+type Program struct {
+	// Words holds the assembled words, indexed by address.
+	Words []Word
+
+	// Lines holds the source line number each word was assembled from
+	// (indexed by address). Cells not assembled from any line are 0.
+	Lines []int
+
+	// Data marks the words assembled from .word directives, as opposed to
+	// instructions (indexed by address).
+	Data []bool
+
+	// Labels maps each label to its address.
+	Labels map[string]int
+}
+
+// Assemble assembles a program written in mnemonic form. Each line holds at
+// most one of the following, optionally preceded by a label ("name:") and
+// followed by a comment (starting with semicolon):
+//
+//	n0 n1 S D       an instruction, with the address written as two 5-bit numbers
+//	addr S D        an instruction, with the address as an expression
+//	S D             an instruction with address 0
+//	.org addr       continue assembling at addr
+//	.word x, ...    one or more data words
+//	.export l, ...  make labels available to other objects (see AssembleObject)
+//	.import l, ...  use labels from other objects (only in objects)
+//	.overlay name   make the object an overlay segment (only in objects)
+//
+// Expressions are sums and differences of decimal numbers, labels, and "."
+// (the address of the current line). Address expressions are taken modulo
+// 1024, so that for example "loop-.-1 K PS" jumps back to loop (PS adds to S,
+// which already holds the address of the next command).
+// Data words can also be written as word literals (see ParseWord).
+func Assemble(program io.Reader) (*Program, error) {
+	a := &assembler{p: &Program{Labels: make(map[string]int)}}
+	if err := a.assemble(program); err != nil {
+		return nil, err
+	}
+	return a.p, nil
+}
+
+// assembler holds the state of an assembly in progress.
+type assembler struct {
+	p *Program
+
+	// These are only used when assembling an object.
+	obj     bool
+	imports map[string]bool
+	exports []string
+	relocs  []Reloc
+	overlay string
+}
+
+func (a *assembler) assemble(program io.Reader) error {
+	type line struct {
+		num    int
+		addr   int
+		fields []string
+	}
+	p := a.p
+	var lines []line
+	exportLines := make(map[string]int)
+
+	// First pass: find the address of every line and label.
+	addr := 0
+	lc := 0
+	sc := bufio.NewScanner(program)
+	for sc.Scan() {
+		lc++
+		cspl := strings.SplitN(sc.Text(), ";", 2) // trim off comment
+		fields := strings.Fields(cspl[0])
+		if len(fields) > 0 && strings.HasSuffix(fields[0], ":") {
+			label := strings.TrimSuffix(fields[0], ":")
+			if !isLabel(label) {
+				return fmt.Errorf("line %d: invalid label %q", lc, label)
+			}
+			if _, dup := p.Labels[label]; dup || a.imports[label] {
+				return fmt.Errorf("line %d: duplicate label %q", lc, label)
+			}
+			p.Labels[label] = addr
+			fields = fields[1:]
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		switch fields[0] {
+		case ".org":
+			if len(fields) != 2 {
+				return fmt.Errorf("line %d: .org needs exactly one address", lc)
+			}
+			v, err := a.eval(fields[1], addr)
+			if err != nil {
+				return fmt.Errorf("line %d: %w", lc, err)
+			}
+			if v.ext != "" {
+				return fmt.Errorf("line %d: .org address cannot use imported label %q", lc, v.ext)
+			}
+			if v.n < 0 || v.n > 1023 {
+				return fmt.Errorf("line %d: .org address %d out of valid range [0,1023]", lc, v.n)
+			}
+			addr = v.n
+			continue
+		case ".word":
+			lines = append(lines, line{num: lc, addr: addr, fields: fields})
+			addr += len(wordArgs(fields))
+			continue
+		case ".export", ".import":
+			args := wordArgs(fields)
+			if len(args) == 0 {
+				return fmt.Errorf("line %d: %s needs at least one label", lc, fields[0])
+			}
+			for _, l := range args {
+				if !isLabel(l) {
+					return fmt.Errorf("line %d: invalid label %q", lc, l)
+				}
+				if fields[0] == ".export" {
+					if _, dup := exportLines[l]; dup {
+						return fmt.Errorf("line %d: label %q already exported", lc, l)
+					}
+					exportLines[l] = lc
+					a.exports = append(a.exports, l)
+					continue
+				}
+				if !a.obj {
+					return fmt.Errorf("line %d: .import can only be used in objects", lc)
+				}
+				if _, dup := p.Labels[l]; dup || a.imports[l] {
+					return fmt.Errorf("line %d: duplicate label %q", lc, l)
+				}
+				a.imports[l] = true
+			}
+			continue
+		case ".overlay":
+			if !a.obj {
+				return fmt.Errorf("line %d: .overlay can only be used in objects", lc)
+			}
+			if len(fields) != 2 || !isLabel(fields[1]) {
+				return fmt.Errorf("line %d: .overlay needs exactly one name", lc)
+			}
+			if a.overlay != "" {
+				return fmt.Errorf("line %d: object is already overlay %q", lc, a.overlay)
+			}
+			a.overlay = fields[1]
+			continue
+		}
+		lines = append(lines, line{num: lc, addr: addr, fields: fields})
+		addr++
+	}
+	if err := sc.Err(); err != nil {
+		return err
+	}
+	for _, l := range a.exports {
+		if _, ok := p.Labels[l]; !ok {
+			return fmt.Errorf("line %d: exported label %q is not defined", exportLines[l], l)
+		}
+	}
+
+	// Second pass: assemble each line.
+	for _, l := range lines {
+		ws, err := a.assembleLine(l.fields, l.addr)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", l.num, err)
+		}
+		for i, w := range ws {
+			at := l.addr + i
+			if at > 1023 {
+				return fmt.Errorf("line %d: address %d out of valid range [0,1023]", l.num, at)
+			}
+			for len(p.Words) <= at {
+				p.Words = append(p.Words, 0)
+				p.Lines = append(p.Lines, 0)
+				p.Data = append(p.Data, false)
+			}
+			if p.Lines[at] != 0 {
+				return fmt.Errorf("line %d: address %d already assembled from line %d", l.num, at, p.Lines[at])
+			}
+			p.Words[at] = w
+			p.Lines[at] = l.num
+			p.Data[at] = l.fields[0] == ".word"
+		}
+	}
+	return nil
+}
+
+// assembleLine assembles the fields of one line at address addr.
+func (a *assembler) assembleLine(fields []string, addr int) ([]Word, error) {
+	if fields[0] == ".word" {
+		args := wordArgs(fields)
+		if len(args) == 0 {
+			return nil, fmt.Errorf(".word needs at least one value")
+		}
+		ws := make([]Word, len(args))
+		for i, arg := range args {
+			if isWordLiteral(arg) {
+				w, err := ParseWord(arg)
+				if err != nil {
+					return nil, err
+				}
+				ws[i] = w
+				continue
+			}
+			v, err := a.eval(arg, addr+i)
+			if err != nil {
+				return nil, err
+			}
+			if v.n < MinInt || v.n > int(allBits) {
+				return nil, fmt.Errorf("value %q = %d out of valid range [%d,%d]", arg, v.n, MinInt, allBits)
+			}
+			if err := a.relocate(v, addr+i, FieldWord); err != nil {
+				return nil, err
+			}
+			ws[i] = IntWord(v.n)
+		}
+		return ws, nil
+	}
+	if strings.HasPrefix(fields[0], ".") && isLabel(fields[0][1:]) {
+		return nil, fmt.Errorf("unknown directive %q", fields[0])
+	}
+
+	var n int
+	switch len(fields) {
+	case 4:
+		w, err := ParseInstruction(strings.Join(fields, " "))
+		return []Word{w}, err
+	case 3:
+		v, err := a.eval(fields[0], addr)
+		if err != nil {
+			return nil, err
+		}
+		if err := a.relocate(v, addr, FieldAddr); err != nil {
+			return nil, err
+		}
+		n = v.n & 0x3ff
+		fields = fields[1:]
+	case 2:
+		// address 0
+	default:
+		return nil, fmt.Errorf("instruction %q has %d fields, want 2, 3 or 4", strings.Join(fields, " "), len(fields))
+	}
+	sv, ok := mnemonicToSource[fields[0]]
+	if !ok {
+		return nil, fmt.Errorf("invalid source %q", fields[0])
+	}
+	dv, ok := mnemonicToDest[fields[1]]
+	if !ok {
+		return nil, fmt.Errorf("invalid destination %q", fields[1])
+	}
+	return []Word{Word(n<<10 + sv<<5 + dv)}, nil
+}
+
+// value is the value of an expression.
+type value struct {
+	n   int    // the value, assuming the program starts at address 0
+	rel int    // the number of addresses within the program, added or subtracted
+	ext string // the imported label added to the value, if any
+}
+
+// relocate records a relocation for the value v used at address addr, if one
+// is needed.
+func (a *assembler) relocate(v value, addr int, field RelocField) error {
+	if !a.obj {
+		return nil
+	}
+	switch {
+	case v.ext != "" && v.rel == 0:
+		a.relocs = append(a.relocs, Reloc{Addr: addr, Field: field, Label: v.ext})
+	case v.ext == "" && v.rel == 1:
+		a.relocs = append(a.relocs, Reloc{Addr: addr, Field: field})
+	case v.ext != "" || v.rel != 0:
+		return fmt.Errorf("expression cannot be relocated")
+	}
+	return nil
+}
+
+// eval evaluates an expression. dot is the value of ".".
+func (a *assembler) eval(expr string, dot int) (value, error) {
+	var v value
+	if expr == "" {
+		return v, fmt.Errorf("empty expression")
+	}
+	sign := 1
+	for expr != "" {
+		switch expr[0] {
+		case '+':
+			expr = expr[1:]
+		case '-':
+			sign = -sign
+			expr = expr[1:]
+		}
+		end := strings.IndexAny(expr, "+-")
+		if end == -1 {
+			end = len(expr)
+		}
+		term := expr[:end]
+		expr = expr[end:]
+		switch {
+		case term == ".":
+			v.n += sign * dot
+			v.rel += sign
+		case a.imports[term]:
+			if v.ext != "" || sign < 0 {
+				return v, fmt.Errorf("imported label %q can only be added once", term)
+			}
+			v.ext = term
+		case isLabel(term):
+			l, ok := a.p.Labels[term]
+			if !ok {
+				return v, fmt.Errorf("undefined label %q", term)
+			}
+			v.n += sign * l
+			v.rel += sign
+		default:
+			n, err := strconv.Atoi(term)
+			if err != nil {
+				return v, fmt.Errorf("invalid term %q", term)
+			}
+			v.n += sign * n
+		}
+		sign = 1
+	}
+	return v, nil
+}
+
+// wordArgs returns the comma-separated arguments of a .word directive.
+// Commas inside a number train (in parentheses) don't separate arguments.
+func wordArgs(fields []string) []string {
+	var args []string
+	add := func(a string) {
+		if a = strings.TrimSpace(a); a != "" {
+			args = append(args, a)
+		}
+	}
+	s, depth, start := strings.Join(fields[1:], " "), 0, 0
+	for i, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				add(s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	add(s[start:])
+	return args
+}
+
+// isWordLiteral reports if a .word argument should be parsed with ParseWord
+// rather than as an expression: a number train, a binary or octal number, or
+// a decimal fraction.
+func isWordLiteral(s string) bool {
+	if strings.HasPrefix(s, "(") {
+		return true
+	}
+	s = strings.TrimLeft(s, "+-")
+	if len(s) > 2 && s[0] == '0' && strings.ContainsRune("bBoO", rune(s[1])) {
+		return true
+	}
+	return s != "" && '0' <= s[0] && s[0] <= '9' && strings.Contains(s, ".")
+}
+
+// isLabel reports if s is a valid label: a letter or underscore, followed by
+// letters, digits, or underscores.
+func isLabel(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, r := range s {
+		switch {
+		case r == '_', 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z':
+		case '0' <= r && r <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,132 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package csirac
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestAssembleLabels(t *testing.T) {
+	// TestCSIRACLoopPKSum, with labels instead of hand-computed addresses.
+	p, err := Assemble(strings.NewReader(`
+		      0  0 A  SA  ; A = 0
+		      8 K  C      ; C = 8
+		loop: C  PK       ; next command += C
+		      data M PA   ; A += data[C]
+		      PE SC       ; C--
+		      SC CS       ; if C < 0 { skip next }
+		      loop-.-1 K PS ; goto loop
+		      0 PL T      ; stop
+
+		      .org 65
+		data: .word 14, 2, 3, 10, 8
+		      .word 3, 2, 9, 6
+	`))
+	if err != nil {
+		t.Fatalf("Assemble() = %v", err)
+	}
+	if got, want := p.Labels, map[string]int{"loop": 2, "data": 65}; !reflect.DeepEqual(got, want) {
+		t.Errorf("p.Labels = %v, want %v", got, want)
+	}
+	if got, want := len(p.Words), 74; got != want {
+		t.Errorf("len(p.Words) = %d, want %d", got, want)
+	}
+	for addr, want := range map[int]Word{
+		1:  MustParseInstruction(" 0  8 K  C"),
+		3:  MustParseInstruction(" 2  1 M  PA"),
+		6:  MustParseInstruction("31 27 K  PS"),
+		66: 2,
+		73: 6,
+	} {
+		if got := p.Words[addr]; got != want {
+			t.Errorf("p.Words[%d] = %v, want %v", addr, got, want)
+		}
+	}
+	for addr, want := range map[int]int{0: 2, 6: 8, 8: 0, 64: 0, 65: 12, 73: 13} {
+		if got := p.Lines[addr]; got != want {
+			t.Errorf("p.Lines[%d] = %d, want %d", addr, got, want)
+		}
+	}
+	for addr, want := range map[int]bool{0: false, 6: false, 8: false, 65: true, 73: true} {
+		if got := p.Data[addr]; got != want {
+			t.Errorf("p.Data[%d] = %t, want %t", addr, got, want)
+		}
+	}
+
+	c := &CSIRAC{M: append(p.Words, 0)}
+	c.K = c.M[0]
+	if err := c.Run(0, false); err != nil {
+		t.Errorf("c.Run(0) = %v, want nil", err)
+	}
+	if got, want := c.A, Word(57); got != want {
+		t.Errorf("after Run: c.A = %d, want %d", got, want)
+	}
+}
+
+func TestAssembleWords(t *testing.T) {
+	p, err := Assemble(strings.NewReader(".word -1, 3+4-2, end, .\nend:"))
+	if err != nil {
+		t.Fatalf("Assemble() = %v", err)
+	}
+	if got, want := p.Words, []Word{allBits, 5, 4, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("p.Words = %v, want %v", got, want)
+	}
+}
+
+func TestAssembleWordLiterals(t *testing.T) {
+	p, err := Assemble(strings.NewReader(".word ( 2,18, 4, 0), -0.5, 0b101, 0o17, (0,0,0,1)"))
+	if err != nil {
+		t.Fatalf("Assemble() = %v", err)
+	}
+	want := []Word{0b00010_10010_00100_00000, 0b11000_00000_00000_00000, 5, 15, 1}
+	if got := p.Words; !reflect.DeepEqual(got, want) {
+		t.Errorf("p.Words = %v, want %v", got, want)
+	}
+}
+
+func TestAssembleErrors(t *testing.T) {
+	tests := []string{
+		"0 0 X A",             // invalid source
+		"0 0 A X",             // invalid destination
+		"32 0 A A",            // first number out of range
+		"nowhere K S",         // undefined label
+		"a: A A\na: A A",      // duplicate label
+		"1a: A A",             // invalid label
+		".org 1024",           // out of range
+		".org",                // missing address
+		".word",               // missing value
+		".byte 4",             // unknown directive
+		"A",                   // too few fields
+		"1 2 3 A A",           // too many fields
+		"A A\n.org 0\nA A",    // overlapping
+		".org 1023\nA A\nA A", // past the end of the store
+		"3*4 K S",             // invalid term
+		".word (1,2,3)",       // short number train
+		".word 1.5",           // fraction out of range
+		".word 0b102",         // invalid binary
+		".word 2000000",       // integer out of range
+		".word -524289",       // integer out of range
+		"a: .word a+1048576",  // expression out of range
+	}
+	for _, test := range tests {
+		if _, err := Assemble(strings.NewReader(test)); err == nil {
+			t.Errorf("Assemble(%q) = nil error, want error", test)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+/*
+   Copyright 2021 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package batch runs many copies of a machine at once, each with its own
+// variation: different console switch settings, a different input tape, or
+// a different program. This is useful for parameter sweeps, for tests, and
+// for grading student submissions.
+package batch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/DrJosh9000/CSIRAC"
+)
+
+// checkInterval is how many steps run between checks for cancellation.
+const checkInterval = 4096
+
+// Job is one variation to run.
+type Job struct {
+	// Name identifies the job in errors.
+	Name string
+
+	// Setup changes the copy of the base machine before it runs, for
+	// example by setting the NA and NB switches or loading an input tape.
+	// It may be nil. The copy has its own stores and input tape, so they
+	// can be changed freely, and it can attach devices of its own.
+	Setup func(c *csirac.CSIRAC)
+}
+
+// Pulse is a word sent to the loudspeaker, and when.
+type Pulse struct {
+	Step int // the number of steps before the one that sent the word
+	Word csirac.Word
+}
+
+// Result is the outcome of running one job.
+type Result struct {
+	// Machine is the machine, as it was when it stopped.
+	Machine *csirac.CSIRAC
+
+	// Steps is the number of instructions executed, including the one that
+	// stopped the machine.
+	Steps int
+
+	// Err is nil if the machine stopped normally (with the T destination).
+	// Otherwise it wraps a *csirac.ErrBudgetExceeded, a *csirac.ErrDevice,
+	// the context's error if the run was cancelled, or describes a panic
+	// (for example from a program reading past the end of a store).
+	Err error
+
+	// Outputs.
+	Printed string  // text printed on the teleprinter
+	Punched []byte  // rows punched on the output tape
+	Speaker []Pulse // words sent to the loudspeaker
+}
+
+// Runner runs jobs on copies of a base machine.
+type Runner struct {
+	// Base is the machine to copy for each job, ready to run (with the
+	// first instruction in K). It isn't changed, and its devices aren't
+	// attached to the copies: each copy records its own outputs.
+	Base *csirac.CSIRAC
+
+	// Budget limits each copy, counting from the start of its job. If it
+	// is the zero Budget, there is no limit (but the run can still be
+	// cancelled with the context). A job's Setup can change its budget.
+	Budget csirac.Budget
+
+	// Workers is the number of jobs to run at once. If zero, GOMAXPROCS
+	// is used.
+	Workers int
+}
+
+// Run runs the jobs, and returns their results in the same order.
+func (r *Runner) Run(ctx context.Context, jobs []Job) []Result {
+	workers := r.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	results := make([]Result, len(jobs))
+	next := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				results[i] = r.run(ctx, jobs[i])
+			}
+		}()
+	}
+	for i := range jobs {
+		next <- i
+	}
+	close(next)
+	wg.Wait()
+	return results
+}
+
+// run runs one job.
+func (r *Runner) run(ctx context.Context, job Job) (res Result) {
+	c := r.Base.Clone()
+	tp, punch := new(csirac.Teleprinter), new(csirac.Punch)
+	c.Attach(tp)
+	c.Attach(punch)
+	c.Attach(csirac.DeviceFunc(func(c *csirac.CSIRAC, e csirac.Event) error {
+		if e.Kind == csirac.EventSound {
+			res.Speaker = append(res.Speaker, Pulse{Step: int(c.Instructions) - 1, Word: e.Word})
+		}
+		return nil
+	}))
+	c.Instructions = 0
+	c.Budget = r.Budget
+	res.Machine = c
+	defer func() {
+		res.Steps = int(c.Instructions)
+		res.Printed = tp.String()
+		res.Punched = punch.Rows
+		if p := recover(); p != nil {
+			res.Err = fmt.Errorf("job %q: panic at step %d: %v", job.Name, res.Steps, p)
+		}
+	}()
+	if job.Setup != nil {
+		job.Setup(c)
+	}
+	for {
+		if c.Instructions%checkInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				res.Err = fmt.Errorf("job %q: %w", job.Name, err)
+				return res
+			}
+		}
+		if err := c.Step(); err != nil {
+			if !errors.Is(err, csirac.ErrStop) {
+				res.Err = fmt.Errorf("job %q: %w", job.Name, err)
+			}
+			return res
+		}
+	}
+}
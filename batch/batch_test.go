@@ -0,0 +1,131 @@
+/*
+   Copyright 2021 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package batch
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/DrJosh9000/CSIRAC"
+)
+
+// base returns a machine running a program that prints the digits 1-5 of
+// NA as a teleprinter character, sends NB to the loudspeaker, and puts
+// NA + NB in A. If NA is zero, it loops forever instead.
+func base(t *testing.T) *csirac.CSIRAC {
+	t.Helper()
+	m, err := csirac.ParseProgram(strings.NewReader(`
+		      NA OT      ; print NA
+		      NB P       ; click
+		      NA A       ; A = NA
+		      NB PA      ; A += NB
+		      NA CS      ; if NA != 0 { skip next }
+		      0 K S      ; goto 0
+		      0 PL T     ; stop
+		      .word 0
+	`))
+	if err != nil {
+		t.Fatalf("ParseProgram() = %v", err)
+	}
+	c := &csirac.CSIRAC{M: m}
+	c.K = c.M[0]
+	return c
+}
+
+func switches(na, nb csirac.Word) func(*csirac.CSIRAC) {
+	return func(c *csirac.CSIRAC) { c.NA, c.NB = na, nb }
+}
+
+func TestRunner(t *testing.T) {
+	b := base(t)
+	r := &Runner{Base: b, Budget: csirac.Budget{Instructions: 100}, Workers: 3}
+	jobs := []Job{
+		{Name: "H", Setup: switches(20, 1)},
+		{Name: "I", Setup: switches(6, 2)},
+		{Name: "loop", Setup: switches(0, 3)},
+		{Name: "short", Setup: func(c *csirac.CSIRAC) {
+			c.NA = 1
+			c.M = c.M[:3]
+		}},
+		{Name: "none"},
+	}
+	results := r.Run(context.Background(), jobs)
+	if len(results) != len(jobs) {
+		t.Fatalf("Run() returned %d results, want %d", len(results), len(jobs))
+	}
+
+	for i, want := range []struct {
+		printed string
+		a       csirac.Word
+		steps   int
+	}{
+		{"H", 21, 6},
+		{"I", 8, 6},
+	} {
+		got := results[i]
+		if got.Err != nil {
+			t.Errorf("%s: Err = %v", jobs[i].Name, got.Err)
+		}
+		if got.Printed != want.printed || got.Machine.A != want.a || got.Steps != want.steps {
+			t.Errorf("%s: Printed, A, Steps = %q, %d, %d; want %q, %d, %d", jobs[i].Name, got.Printed, got.Machine.A, got.Steps, want.printed, want.a, want.steps)
+		}
+	}
+	if got, want := results[1].Speaker, []Pulse{{Step: 1, Word: 2}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("I: Speaker = %v, want %v", got, want)
+	}
+
+	loop := results[2]
+	if be := new(csirac.ErrBudgetExceeded); !errors.As(loop.Err, &be) {
+		t.Errorf("loop: Err = %v, want *csirac.ErrBudgetExceeded", loop.Err)
+	}
+	if loop.Steps != 100 || len(loop.Speaker) != 17 {
+		t.Errorf("loop: Steps, len(Speaker) = %d, %d; want 100, 17", loop.Steps, len(loop.Speaker))
+	}
+
+	short := results[3]
+	if short.Err == nil || !strings.Contains(short.Err.Error(), "panic at step 3") {
+		t.Errorf("short: Err = %v, want panic at step 3", short.Err)
+	}
+	if short.Printed != "E" {
+		t.Errorf("short: Printed = %q, want %q", short.Printed, "E")
+	}
+
+	// With NA = 0 and no limit, "none" would loop forever, but the limit
+	// stops it.
+	if be := new(csirac.ErrBudgetExceeded); !errors.As(results[4].Err, &be) {
+		t.Errorf("none: Err = %v, want *csirac.ErrBudgetExceeded", results[4].Err)
+	}
+
+	// The base machine is unchanged.
+	if b.NA != 0 || b.A != 0 || len(b.M) != 8 {
+		t.Errorf("base machine changed: %+v", b)
+	}
+}
+
+func TestRunnerCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r := &Runner{Base: base(t)}
+	for _, res := range r.Run(ctx, make([]Job, 4)) {
+		if !errors.Is(res.Err, context.Canceled) {
+			t.Errorf("Err = %v, want context.Canceled", res.Err)
+		}
+	}
+}
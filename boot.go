@@ -0,0 +1,82 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package csirac
+
+import "fmt"
+
+// Bootstrap is a primary input routine, which loads a program from tape into
+// the main store and then jumps to it. Rather than copying a program into M
+// directly, this is how programs were put into the machine.
+//
+// The tape (see BootTape) holds words two rows at a time (see WordsToRows).
+// The first word is the load address (in the upper half, as with the K
+// source), the second is the number of words n, and the next n words are the
+// program itself. Once loaded, the routine jumps to the load address.
+//
+// The routine only uses relative jumps, so it can be placed anywhere in M. It
+// uses registers A, C, H, D14 and D15, and selects binary input mode.
+var Bootstrap = MustParseProgram(`
+	 0  0 Z  Q   ; binary input
+	 0  0 I  HL  ; H = upper half of load address
+	 0  0 HU A   ; A = H in upper half
+	 0  0 I  HL  ; H = lower half of load address
+	 0  0 HL PA  ; A += H
+	 0  0 A  C   ; C = load address
+	 0 15 A  D   ; D15 = load address
+	 0  0 I  HL  ; H = upper half of word count
+	 0  0 HU A   ; A = H in upper half
+	 0  0 I  HL  ; H = lower half of word count
+	 0  0 HL PA  ; A += H
+	 0 14 A  D   ; D14 = word count
+	 0 14 D  A   ; loop: A = D14
+	 0  0 ZA CS  ; if A != 0 { skip next }
+	 0 15 D  S   ; goto load address
+	 0  0 I  HL  ; H = upper half of next word
+	 0  0 HU A   ; A = H in upper half
+	 0  0 I  HL  ; H = lower half of next word
+	 0  0 HL PA  ; A += H
+	 0  0 C  PK  ; next command += C
+	 0  0 A  M   ; M[C] = A
+	 0  0 PE PC  ; C += P11
+	 0 14 PL SD  ; D14--
+	31 20 K  PS  ; goto loop
+`)
+
+// BootTape returns a tape that Bootstrap will load into M starting at addr,
+// and then jump to addr.
+func BootTape(addr int, program []Word) *InputTape {
+	ws := make([]Word, 0, len(program)+2)
+	ws = append(ws, IntWord(addr)<<10&hi10, IntWord(len(program)))
+	ws = append(ws, program...)
+	return &InputTape{Rows: WordsToRows(ws)}
+}
+
+// Boot places Bootstrap at the top of M, loads the tape into the reader, and
+// sets S and K so that the next Step begins executing the routine.
+func (c *CSIRAC) Boot(t *InputTape) error {
+	// Leave one spare cell at the end, since Step fetches the command after
+	// the final jump before making the jump.
+	base := len(c.M) - len(Bootstrap) - 1
+	if base < 0 {
+		return fmt.Errorf("main store too small (%d words) for bootstrap (%d words)", len(c.M), len(Bootstrap)+1)
+	}
+	copy(c.M[base:], Bootstrap)
+	c.Input = t
+	c.S = IntWord(base) << 10
+	c.K = c.M[base]
+	return nil
+}
@@ -0,0 +1,96 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package csirac
+
+import "testing"
+
+func TestBootCountDownLoop(t *testing.T) {
+	// The count down loop from TestCSIRACCountDownLoop, loaded from tape at
+	// address 5 instead of being copied into M.
+	program := MustParseProgram(`
+		 0 13 K  HU  ; H = 13
+		 0  0 HL A   ; A = H
+		 1 15 K  HU  ; H = 47
+		 0  0 HL B   ; B = H
+		 0  8 K  C   ; C = 8
+		 0  0 B  PA  ; A += B
+		 0  0 PE SC  ; C--
+		 0  0 SC CS  ; if C < 0 { skip next }
+		31 28 K  PS  ; goto (line - 3)
+		 0  0 PL T   ; stop
+	`)
+	c := &CSIRAC{M: make([]Word, 64)}
+	if err := c.Boot(BootTape(5, program)); err != nil {
+		t.Fatalf("c.Boot() = %v", err)
+	}
+	if err := c.Run(0, false); err != nil {
+		t.Fatalf("c.Run(0) = %v, want nil", err)
+	}
+	if got, want := c.A, Word(13+9*47); got != want {
+		t.Errorf("after Run: c.A = %d, want %d", got, want)
+	}
+	for i, w := range program {
+		if got := c.M[5+i]; got != w {
+			t.Errorf("after Run: c.M[%d] = %v, want %v", 5+i, got, w)
+		}
+	}
+	if got, want := c.Input.Pos, len(c.Input.Rows); got != want {
+		t.Errorf("after Run: c.Input.Pos = %d, want %d", got, want)
+	}
+}
+
+func TestBootDecimalInput(t *testing.T) {
+	// A program that switches the reader to decimal and adds up the digits
+	// following it on the tape, until it reads a row with hole 12 punched.
+	program := MustParseProgram(`
+		 0  0 PL Q   ; decimal input
+		 0  0 Z  C   ; C = 0
+		 0  0 I  A   ; loop: A = next digit
+		 0  0 A  HU  ; H = upper half of A (control holes)
+		 0  0 HL CS  ; if H != 0 { skip next }
+		 0  0 PE PS  ; skip next
+		 0  0 PL T   ; stop
+		 0  0 A  PC  ; C += A
+		31 25 K  PS  ; goto loop
+	`)
+	tape := BootTape(0, program)
+	for _, d := range []int{4, 0, 9, 2, 7} {
+		tape.Rows = append(tape.Rows, DecimalRow(d))
+	}
+	tape.Rows = append(tape.Rows, 1<<11) // hole 12: end of data
+
+	c := &CSIRAC{M: make([]Word, 64)}
+	if err := c.Boot(tape); err != nil {
+		t.Fatalf("c.Boot() = %v", err)
+	}
+	if err := c.Run(0, false); err != nil {
+		t.Fatalf("c.Run(0) = %v, want nil", err)
+	}
+	if !c.Decimal {
+		t.Error("after Run: c.Decimal = false, want true")
+	}
+	if got, want := c.C, Word(4+0+9+2+7); got != want {
+		t.Errorf("after Run: c.C = %d, want %d", got, want)
+	}
+}
+
+func TestBootStoreTooSmall(t *testing.T) {
+	c := &CSIRAC{M: make([]Word, len(Bootstrap))}
+	if err := c.Boot(BootTape(0, nil)); err == nil {
+		t.Error("c.Boot() = nil error, want error")
+	}
+}
@@ -0,0 +1,72 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package csirac
+
+import (
+	"fmt"
+	"time"
+)
+
+// InstructionTime is the simulated time taken by each instruction. CSIRAC
+// ran roughly a thousand instructions per second. The simulation doesn't
+// model the different times taken by different instructions (such as waiting
+// for a drum to come around).
+const InstructionTime = time.Millisecond
+
+// Budget limits how long the machine may run, so that a program that never
+// stops (whether by mistake or by design) can't run forever. A zero field
+// means no limit of that kind. Both limits are counted from when the
+// Instructions counter was last zero, not from when the budget was set.
+type Budget struct {
+	// Instructions is the most instructions the machine may execute.
+	Instructions int64
+
+	// Time is the most simulated time the machine may take (see
+	// InstructionTime).
+	Time time.Duration
+}
+
+// exceeded reports whether executing one more instruction, after n have been
+// executed, would exceed the budget.
+func (b Budget) exceeded(n int64) bool {
+	if b.Instructions > 0 && n >= b.Instructions {
+		return true
+	}
+	if b.Time > 0 && time.Duration(n+1)*InstructionTime > b.Time {
+		return true
+	}
+	return false
+}
+
+// ErrBudgetExceeded is returned by Step (and so by Run) instead of executing
+// an instruction that would exceed the machine's Budget. The machine is left
+// as it was, so it can continue if the budget is raised.
+type ErrBudgetExceeded struct {
+	Budget       Budget        // the budget that was exceeded
+	Instructions int64         // the instructions executed so far
+	Time         time.Duration // the simulated time taken so far
+}
+
+func (e *ErrBudgetExceeded) Error() string {
+	return fmt.Sprintf("budget exceeded after %d instructions (%v simulated)", e.Instructions, e.Time)
+}
+
+// Time returns the time on the simulated clock: the time the real machine
+// would have taken to execute the instructions executed so far.
+func (c *CSIRAC) Time() time.Duration {
+	return time.Duration(c.Instructions) * InstructionTime
+}
@@ -0,0 +1,88 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package csirac
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestBudget(t *testing.T) {
+	tests := []struct {
+		name   string
+		budget Budget
+		want   int64
+	}{
+		{name: "instructions", budget: Budget{Instructions: 100}, want: 100},
+		{name: "time", budget: Budget{Time: 250 * time.Millisecond}, want: 250},
+		{name: "part instruction time", budget: Budget{Time: 2500 * time.Microsecond}, want: 2},
+		{name: "instructions first", budget: Budget{Instructions: 10, Time: time.Second}, want: 10},
+		{name: "time first", budget: Budget{Instructions: 1000, Time: 20 * time.Millisecond}, want: 20},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &CSIRAC{
+				M: []Word{
+					0: MustParseInstruction(" 0  0 K  S"), // goto 0, forever
+					1: 0,
+				},
+				Budget: test.budget,
+			}
+			c.K = c.M[0]
+			err := c.Run(0, false)
+			var be *ErrBudgetExceeded
+			if !errors.As(err, &be) {
+				t.Fatalf("c.Run(0) = %v, want *ErrBudgetExceeded", err)
+			}
+			if be.Instructions != test.want || be.Time != time.Duration(test.want)*InstructionTime || be.Budget != test.budget {
+				t.Errorf("c.Run(0) = %+v, want %d instructions", be, test.want)
+			}
+			if c.Instructions != test.want {
+				t.Errorf("after Run: c.Instructions = %d, want %d", c.Instructions, test.want)
+			}
+
+			// The machine can carry on with more budget.
+			c.Budget = Budget{Instructions: test.want + 5}
+			if err := c.Run(0, false); !errors.As(err, &be) {
+				t.Fatalf("c.Run(0) = %v, want *ErrBudgetExceeded", err)
+			}
+			if got, want := c.Instructions, test.want+5; got != want {
+				t.Errorf("after second Run: c.Instructions = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestBudgetNotExceeded(t *testing.T) {
+	// A program that stops after exactly its budget isn't over it.
+	c := &CSIRAC{
+		M: []Word{
+			0: MustParseInstruction(" 0  0 K  A"),
+			1: MustParseInstruction("31 31 K  T"),
+			2: 0,
+		},
+		Budget: Budget{Instructions: 2, Time: 2 * InstructionTime},
+	}
+	c.K = c.M[0]
+	if err := c.Run(0, false); err != nil {
+		t.Errorf("c.Run(0) = %v, want nil", err)
+	}
+	if got, want := c.Time(), 2*InstructionTime; got != want {
+		t.Errorf("after Run: c.Time() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,191 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// The cslink program assembles relocatable objects and links them into
+// memory images.
+//
+// Usage:
+//
+//	cslink [-size words] [-lib names] [-o image] [-map file] module ...
+//	cslink -c source ...
+//
+// Each module is either assembler source (.s or .asm), which is assembled as
+// an object, or an object written by cslink -c (.obj). A module is placed in
+// the main store unless its name is followed by @MA, @MB, @MC or @MD, which
+// places it on that drum instead. Modules are placed in the order given,
+// followed by any routines from the standard library named with -lib (see
+// package stdlib).
+//
+// Overlay segments (objects declared with .overlay) must be placed on a drum.
+// The linker adds a loader for each one (see link.Link).
+//
+// The main store image is written to the -o file. For each drum that holds a
+// module, an image is also written to the same name with the drum added
+// before the extension: for example, prog.ma.img.
+//
+// With -c, each source file is assembled into an object file with the same
+// name and the extension .obj, and nothing is linked.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/DrJosh9000/CSIRAC"
+	"github.com/DrJosh9000/CSIRAC/link"
+	"github.com/DrJosh9000/CSIRAC/stdlib"
+)
+
+var (
+	compileOnly = flag.Bool("c", false, "only assemble sources into objects")
+	libs        = flag.String("lib", "", "comma-separated standard library routines to link")
+	mapFile     = flag.String("map", "", "file to write the link map to (\"-\" for stdout)")
+	output      = flag.String("o", "a.img", "main store image file")
+	size        = flag.Int("size", 1024, "words of main store available (768 or 1024)")
+)
+
+var stores = map[string]link.Store{
+	"M":  link.M,
+	"MA": link.MA,
+	"MB": link.MB,
+	"MC": link.MC,
+	"MD": link.MD,
+}
+
+func main() {
+	flag.Usage = func() {
+		out := flag.CommandLine.Output()
+		fmt.Fprintf(out, "Usage: %s [-size words] [-lib names] [-o image] [-map file] module ...\n", os.Args[0])
+		fmt.Fprintf(out, "       %s -c source ...\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 && (*compileOnly || *libs == "") {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if *compileOnly {
+		for _, name := range flag.Args() {
+			o, err := assemble(name)
+			if err != nil {
+				log.Fatalf("Couldn't assemble %s: %v", name, err)
+			}
+			objName := strings.TrimSuffix(name, filepath.Ext(name)) + ".obj"
+			if err := create(objName, func(f *os.File) error { return csirac.WriteObject(f, o) }); err != nil {
+				log.Fatalf("Couldn't write %s: %v", objName, err)
+			}
+		}
+		return
+	}
+
+	var mods []link.Module
+	for _, arg := range flag.Args() {
+		name, store := arg, link.M
+		if i := strings.LastIndex(arg, "@"); i >= 0 {
+			s, ok := stores[strings.ToUpper(arg[i+1:])]
+			if !ok {
+				log.Fatalf("Unknown store in %q", arg)
+			}
+			name, store = arg[:i], s
+		}
+		o, err := load(name)
+		if err != nil {
+			log.Fatalf("Couldn't load %s: %v", name, err)
+		}
+		mods = append(mods, link.Module{Name: name, Object: o, Store: store})
+	}
+	if *libs != "" {
+		for _, name := range strings.Split(*libs, ",") {
+			o, err := stdlib.Object(name)
+			if err != nil {
+				log.Fatalf("Couldn't assemble library routine: %v", err)
+			}
+			mods = append(mods, link.Module{Name: name, Object: o})
+		}
+	}
+
+	img, err := link.Link(mods, link.Config{Size: *size})
+	if err != nil {
+		log.Fatalf("Couldn't link: %v", err)
+	}
+
+	if err := create(*output, func(f *os.File) error { return csirac.WriteImage(f, img.M) }); err != nil {
+		log.Fatalf("Couldn't write %s: %v", *output, err)
+	}
+	ext := filepath.Ext(*output)
+	for i, d := range img.Drums {
+		if len(d) == 0 {
+			continue
+		}
+		name := strings.TrimSuffix(*output, ext) + "." + strings.ToLower((link.MA + link.Store(i)).String()) + ext
+		if err := create(name, func(f *os.File) error { return csirac.WriteImage(f, d) }); err != nil {
+			log.Fatalf("Couldn't write %s: %v", name, err)
+		}
+	}
+
+	switch *mapFile {
+	case "":
+	case "-":
+		if err := img.WriteMap(os.Stdout); err != nil {
+			log.Fatalf("Couldn't write map: %v", err)
+		}
+	default:
+		if err := create(*mapFile, func(f *os.File) error { return img.WriteMap(f) }); err != nil {
+			log.Fatalf("Couldn't write %s: %v", *mapFile, err)
+		}
+	}
+}
+
+// assemble assembles a source file as an object.
+func assemble(name string) (*csirac.Object, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return csirac.AssembleObject(f)
+}
+
+// load reads an object file, or assembles a source file.
+func load(name string) (*csirac.Object, error) {
+	if filepath.Ext(name) != ".obj" {
+		return assemble(name)
+	}
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return csirac.ReadObject(f)
+}
+
+// create creates a file, writes to it with write, and closes it.
+func create(name string, write func(*os.File) error) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,200 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// The tapeconv program converts between tape images, assembler source, and
+// memory images.
+//
+// Usage:
+//
+//	tapeconv [-from kind] [-to kind] input output
+//
+// Either file may be "-" for stdin or stdout. If not given with flags, the
+// kinds are inferred from the file extensions:
+//
+//	.s, .asm  assembler source
+//	.img      memory image
+//	.t12      12-hole input tape, raw
+//	.h12      12-hole input tape, holes
+//	.t5       5-hole output tape, raw
+//	.h5       5-hole output tape, holes
+//
+// Words are stored on 12-hole tape two rows per word (see tape.FromWords).
+// A 5-hole tape can only be written if no row uses holes beyond 5.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/DrJosh9000/CSIRAC"
+	"github.com/DrJosh9000/CSIRAC/tape"
+)
+
+var (
+	fromKind = flag.String("from", "", "kind of input file (default: from extension)")
+	toKind   = flag.String("to", "", "kind of output file (default: from extension)")
+)
+
+var extKinds = map[string]string{
+	".s":   "asm",
+	".asm": "asm",
+	".img": "img",
+	".t12": "t12",
+	".h12": "h12",
+	".t5":  "t5",
+	".h5":  "h5",
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [-from kind] [-to kind] input output\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	inName, outName := flag.Arg(0), flag.Arg(1)
+
+	from, err := kindOf(*fromKind, inName)
+	if err != nil {
+		log.Fatalf("Input: %v", err)
+	}
+	to, err := kindOf(*toKind, outName)
+	if err != nil {
+		log.Fatalf("Output: %v", err)
+	}
+
+	in := os.Stdin
+	if inName != "-" {
+		f, err := os.Open(inName)
+		if err != nil {
+			log.Fatalf("Couldn't open input: %v", err)
+		}
+		defer f.Close()
+		in = f
+	}
+	t, err := read(in, from)
+	if err != nil {
+		log.Fatalf("Couldn't read %s: %v", inName, err)
+	}
+
+	out := os.Stdout
+	if outName != "-" {
+		f, err := os.Create(outName)
+		if err != nil {
+			log.Fatalf("Couldn't create output: %v", err)
+		}
+		out = f
+	}
+	if err := write(out, to, t); err != nil {
+		log.Fatalf("Couldn't write %s: %v", outName, err)
+	}
+	if err := out.Close(); err != nil {
+		log.Fatalf("Couldn't close output: %v", err)
+	}
+}
+
+// kindOf returns the kind of file, either as given or from the extension.
+func kindOf(kind, name string) (string, error) {
+	if kind == "" {
+		kind = extKinds[filepath.Ext(name)]
+		if kind == "" {
+			return "", fmt.Errorf("can't infer kind of %q; use -from or -to", name)
+		}
+	}
+	for _, k := range extKinds {
+		if k == kind {
+			return kind, nil
+		}
+	}
+	return "", fmt.Errorf("unknown kind %q", kind)
+}
+
+// read reads any kind of file as a 12-hole tape, which all the other kinds
+// convert into without loss.
+func read(r io.Reader, kind string) (tape.Input, error) {
+	switch kind {
+	case "asm":
+		ws, err := csirac.ParseProgram(r)
+		if err != nil {
+			return nil, err
+		}
+		return tape.FromWords(ws), nil
+	case "img":
+		ws, err := csirac.ReadImage(r)
+		if err != nil {
+			return nil, err
+		}
+		return tape.FromWords(ws), nil
+	case "t12":
+		return tape.ReadInput(r, tape.Raw)
+	case "h12":
+		return tape.ReadInput(r, tape.Holes)
+	case "t5":
+		t, err := tape.ReadOutput(r, tape.Raw)
+		return t.Input(), err
+	case "h5":
+		t, err := tape.ReadOutput(r, tape.Holes)
+		return t.Input(), err
+	}
+	return nil, fmt.Errorf("unknown kind %q", kind)
+}
+
+// write writes the 12-hole tape as any kind of file.
+func write(w io.Writer, kind string, t tape.Input) error {
+	switch kind {
+	case "asm":
+		ws, err := t.Words()
+		if err != nil {
+			return err
+		}
+		bw := bufio.NewWriter(w)
+		for i, x := range ws {
+			fmt.Fprintf(bw, "%s  ; %4d: %v\n", x.InstructionString(), i, x)
+		}
+		return bw.Flush()
+	case "img":
+		ws, err := t.Words()
+		if err != nil {
+			return err
+		}
+		return csirac.WriteImage(w, ws)
+	case "t12":
+		return t.Write(w, tape.Raw)
+	case "h12":
+		return t.Write(w, tape.Holes)
+	case "t5", "h5":
+		out := make(tape.Output, len(t))
+		for i, row := range t {
+			if row&^0x1f != 0 {
+				return fmt.Errorf("row %d uses holes beyond 5", i)
+			}
+			out[i] = byte(row)
+		}
+		if kind == "t5" {
+			return out.Write(w, tape.Raw)
+		}
+		return out.Write(w, tape.Holes)
+	}
+	return fmt.Errorf("unknown kind %q", kind)
+}
@@ -0,0 +1,103 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/DrJosh9000/CSIRAC"
+	"github.com/DrJosh9000/CSIRAC/tape"
+)
+
+// kinds lists every kind of file, in the order of the usage.
+var kinds = []string{"asm", "img", "t12", "h12", "t5", "h5"}
+
+func TestRoundTrip(t *testing.T) {
+	// Every kind can hold this tape: whole words, using only holes 1-5.
+	want := tape.FromWords([]csirac.Word{0, 1<<10 | 2, 31<<10 | 31, 17 << 10, 5})
+	for _, from := range kinds {
+		for _, to := range kinds {
+			// Convert from -> to -> from.
+			var src bytes.Buffer
+			if err := write(&src, from, want); err != nil {
+				t.Fatalf("write(%s) = %v", from, err)
+			}
+			got := want
+			for _, kind := range []string{from, to, from} {
+				var buf bytes.Buffer
+				if err := write(&buf, kind, got); err != nil {
+					t.Fatalf("%s -> %s: write(%s) = %v", from, to, kind, err)
+				}
+				var err error
+				if got, err = read(&buf, kind); err != nil {
+					t.Fatalf("%s -> %s: read(%s) = %v", from, to, kind, err)
+				}
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("%s -> %s -> %s: got tape %v, want %v", from, to, from, got, want)
+			}
+			var dst bytes.Buffer
+			if err := write(&dst, from, got); err != nil {
+				t.Fatalf("write(%s) = %v", from, err)
+			}
+			if !bytes.Equal(dst.Bytes(), src.Bytes()) {
+				t.Errorf("%s -> %s -> %s: got file\n%s\nwant\n%s", from, to, from, dst.Bytes(), src.Bytes())
+			}
+		}
+	}
+}
+
+func TestWriteErrors(t *testing.T) {
+	tests := []struct {
+		kind string
+		t    tape.Input
+	}{
+		{"asm", tape.Input{1, 2, 3}},    // odd number of rows
+		{"img", tape.Input{0x400, 0}},   // control hole punched
+		{"t5", tape.Input{0x20}},        // hole 6 punched
+		{"h5", tape.Input{0, 1, 0x3ff}}, // holes 6-10 punched
+	}
+	for _, test := range tests {
+		if err := write(new(bytes.Buffer), test.kind, test.t); err == nil {
+			t.Errorf("write(%s, %v) = nil error, want error", test.kind, test.t)
+		}
+	}
+}
+
+func TestKindOf(t *testing.T) {
+	tests := []struct {
+		kind, name, want string
+	}{
+		{"", "prog.s", "asm"},
+		{"", "prog.asm", "asm"},
+		{"", "tape.h12", "h12"},
+		{"img", "-", "img"},
+		{"t5", "tape.h12", "t5"},
+	}
+	for _, test := range tests {
+		if got, err := kindOf(test.kind, test.name); err != nil || got != test.want {
+			t.Errorf("kindOf(%q, %q) = %q, %v, want %q, nil", test.kind, test.name, got, err, test.want)
+		}
+	}
+	for _, test := range [][2]string{{"", "-"}, {"", "prog.txt"}, {"tape", "prog.s"}} {
+		if _, err := kindOf(test[0], test[1]); err == nil {
+			t.Errorf("kindOf(%q, %q) = nil error, want error", test[0], test[1])
+		}
+	}
+}
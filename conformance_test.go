@@ -0,0 +1,273 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package csirac
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// The conformance suite is the programs in testdata/conformance. Each one
+// checks a few sources or destinations, and begins with a header of comment
+// lines which can include directives like these:
+//
+//	; setup: A=-6 NA=(1,2,3,4) M[data]=7 tape=5,4095
+//	; want: A=12 D3=0.25 M[out]=-1 OT=8,5 steps=7
+//
+// setup sets registers, console switches and store cells, and the rows of
+// the input tape (tape), before the program runs from address 0. The program
+// must stop with the T destination. want checks registers and store cells
+// afterwards, along with the words sent to the teleprinter (OT), the tape
+// punch (OP) and the loudspeaker (P), and the number of instructions
+// executed (steps). Store addresses are numbers or labels, and values are
+// word literals (see ParseWord) without spaces.
+
+// conformanceDirective is one key=value from a header.
+type conformanceDirective struct {
+	line       int
+	key, value string
+}
+
+// parseConformanceHeader returns the setup and want directives in the
+// header of a conformance program.
+func parseConformanceHeader(src []byte) (setup, want []conformanceDirective, err error) {
+	for i, line := range strings.Split(string(src), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if !strings.HasPrefix(line, ";") {
+			break
+		}
+		line = strings.TrimSpace(strings.TrimPrefix(line, ";"))
+		var list *[]conformanceDirective
+		switch {
+		case strings.HasPrefix(line, "setup:"):
+			list, line = &setup, strings.TrimPrefix(line, "setup:")
+		case strings.HasPrefix(line, "want:"):
+			list, line = &want, strings.TrimPrefix(line, "want:")
+		default:
+			continue
+		}
+		for _, f := range strings.Fields(line) {
+			kv := strings.SplitN(f, "=", 2)
+			if len(kv) != 2 {
+				return nil, nil, fmt.Errorf("line %d: %q is not key=value", i+1, f)
+			}
+			*list = append(*list, conformanceDirective{line: i + 1, key: kv[0], value: kv[1]})
+		}
+	}
+	return setup, want, nil
+}
+
+// conformanceWord returns the register or store cell named by key.
+func conformanceWord(c *CSIRAC, prog *Program, key string) (*Word, error) {
+	regs := map[string]*Word{
+		"A": &c.A, "B": &c.B, "C": &c.C, "H": &c.H, "S": &c.S, "K": &c.K,
+		"I": &c.I, "NA": &c.NA, "NB": &c.NB, "IS": &c.IS, "T": &c.T,
+	}
+	for i := range c.D {
+		regs["D"+strconv.Itoa(i)] = &c.D[i]
+	}
+	if w := regs[key]; w != nil {
+		return w, nil
+	}
+	i := strings.IndexByte(key, '[')
+	if i < 0 || !strings.HasSuffix(key, "]") {
+		return nil, fmt.Errorf("unknown register %q", key)
+	}
+	stores := map[string][]Word{"M": c.M, "MA": c.MA, "MB": c.MB, "MC": c.MC, "MD": c.MD}
+	store, ok := stores[key[:i]]
+	if !ok {
+		return nil, fmt.Errorf("unknown store %q", key[:i])
+	}
+	at := key[i+1 : len(key)-1]
+	addr, ok := prog.Labels[at]
+	if !ok {
+		n, err := strconv.Atoi(at)
+		if err != nil {
+			return nil, fmt.Errorf("address %q is neither a number nor a label", at)
+		}
+		addr = n
+	}
+	if addr < 0 || addr >= len(store) {
+		return nil, fmt.Errorf("address %d out of range", addr)
+	}
+	return &store[addr], nil
+}
+
+// conformanceWords parses a comma-separated list of word literals.
+func conformanceWords(s string) ([]Word, error) {
+	var ws []Word
+	for _, arg := range wordArgs([]string{"", s}) {
+		w, err := ParseWord(arg)
+		if err != nil {
+			return nil, err
+		}
+		ws = append(ws, w)
+	}
+	return ws, nil
+}
+
+// conformanceUsed records which sources and destinations the suite executes.
+type conformanceUsed struct {
+	sources, dests [32]bool
+}
+
+func TestConformance(t *testing.T) {
+	files, err := filepath.Glob("testdata/conformance/*.s")
+	if err != nil {
+		t.Fatalf("Glob() = %v", err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no conformance programs found")
+	}
+	var used conformanceUsed
+	ran := 0
+	for _, name := range files {
+		name := name
+		t.Run(strings.TrimSuffix(filepath.Base(name), ".s"), func(t *testing.T) {
+			ran++
+			runConformance(t, name, &used)
+		})
+	}
+	if ran < len(files) {
+		// Some programs were left out with -run.
+		return
+	}
+
+	// Every source and destination should be tested somewhere.
+	for i := 0; i < 32; i++ {
+		src, dst := Word(i<<5 | i).Mnemonics()
+		if !used.sources[i] {
+			t.Errorf("source %d (%s) isn't executed by any conformance program", i, src)
+		}
+		if !used.dests[i] {
+			t.Errorf("destination %d (%s) isn't executed by any conformance program", i, dst)
+		}
+	}
+}
+
+func runConformance(t *testing.T, name string, used *conformanceUsed) {
+	src, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatalf("ReadFile() = %v", err)
+	}
+	prog, err := Assemble(bytes.NewReader(src))
+	if err != nil {
+		t.Fatalf("%s: Assemble() = %v", name, err)
+	}
+	setup, want, err := parseConformanceHeader(src)
+	if err != nil {
+		t.Fatalf("%s: %v", name, err)
+	}
+
+	c := &CSIRAC{
+		M:      make([]Word, 1024),
+		MA:     make([]Word, 1024),
+		MB:     make([]Word, 1024),
+		MC:     make([]Word, 1024),
+		MD:     make([]Word, 1024),
+		Budget: Budget{Instructions: 10000},
+	}
+	outputs := make(map[string][]Word)
+	dests := map[EventKind]string{EventPrint: "OT", EventPunch: "OP", EventSound: "P"}
+	c.Attach(DeviceFunc(func(_ *CSIRAC, e Event) error {
+		if dev, ok := dests[e.Kind]; ok {
+			outputs[dev] = append(outputs[dev], e.Word)
+		}
+		return nil
+	}))
+	copy(c.M, prog.Words)
+
+	for _, d := range setup {
+		ws, err := conformanceWords(d.value)
+		if err != nil {
+			t.Fatalf("%s:%d: %s: %v", name, d.line, d.key, err)
+		}
+		if d.key == "tape" {
+			c.Input = new(InputTape)
+			for _, w := range ws {
+				if w > 0xfff {
+					t.Fatalf("%s:%d: tape row %v has holes beyond 12", name, d.line, w)
+				}
+				c.Input.Rows = append(c.Input.Rows, uint16(w))
+			}
+			continue
+		}
+		p, err := conformanceWord(c, prog, d.key)
+		if err != nil {
+			t.Fatalf("%s:%d: %v", name, d.line, err)
+		}
+		if len(ws) != 1 {
+			t.Fatalf("%s:%d: %s needs exactly one value", name, d.line, d.key)
+		}
+		*p = ws[0]
+	}
+
+	c.K = c.M[0]
+	for {
+		used.sources[c.K.Source()] = true
+		used.dests[c.K.Dest()] = true
+		if err := c.Step(); err != nil {
+			if errors.Is(err, ErrStop) {
+				break
+			}
+			t.Fatalf("%s: Step() = %v", name, err)
+		}
+	}
+
+	for _, d := range want {
+		switch d.key {
+		case "steps":
+			n, err := strconv.ParseInt(d.value, 10, 64)
+			if err != nil {
+				t.Fatalf("%s:%d: steps: %v", name, d.line, err)
+			}
+			if c.Instructions != n {
+				t.Errorf("%s:%d: executed %d instructions, want %d", name, d.line, c.Instructions, n)
+			}
+		case "OT", "OP", "P":
+			ws, err := conformanceWords(d.value)
+			if err != nil {
+				t.Fatalf("%s:%d: %s: %v", name, d.line, d.key, err)
+			}
+			if got := outputs[d.key]; !reflect.DeepEqual(got, ws) {
+				t.Errorf("%s:%d: %s received %v, want %v", name, d.line, d.key, got, ws)
+			}
+		default:
+			p, err := conformanceWord(c, prog, d.key)
+			if err != nil {
+				t.Fatalf("%s:%d: %v", name, d.line, err)
+			}
+			w, err := ParseWord(d.value)
+			if err != nil {
+				t.Fatalf("%s:%d: %s: %v", name, d.line, d.key, err)
+			}
+			if *p != w {
+				t.Errorf("%s:%d: %s = %v (%d), want %v (%d)", name, d.line, d.key, *p, *p, w, w)
+			}
+		}
+	}
+}
@@ -0,0 +1,247 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package cover measures how much of a program its tests exercise: which
+// instructions were executed, which were never reached, and whether each
+// conditional skip (the CS destination) both skipped and didn't skip. The
+// results are reported against the lines of the program's source, as text or
+// as an HTML page.
+package cover
+
+import (
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+
+	"github.com/DrJosh9000/CSIRAC"
+)
+
+// destCS is the CS destination.
+const destCS = 25
+
+// Coverage records the instructions executed by a machine. Run several
+// programs or tests with the same Coverage to combine their coverage.
+type Coverage struct {
+	// Executed records which addresses in the main store were executed.
+	Executed [1024]bool
+
+	// Skipped and NotSkipped record which CS instructions (by address)
+	// caused the next instruction to be skipped, and which didn't.
+	Skipped, NotSkipped [1024]bool
+}
+
+// Step records the instruction in K, and executes it with c.Step.
+func (cv *Coverage) Step(c *csirac.CSIRAC) error {
+	k, addr := c.K, c.S.Hi()
+	err := c.Step()
+	var be *csirac.ErrBudgetExceeded
+	if errors.As(err, &be) {
+		// Nothing was executed.
+		return err
+	}
+	cv.Executed[addr] = true
+	if k.Dest() == destCS {
+		if c.S.Hi() == (addr+1)&0x3ff {
+			cv.NotSkipped[addr] = true
+		} else {
+			cv.Skipped[addr] = true
+		}
+	}
+	return err
+}
+
+// Run runs the machine until it stops, like c.Run(0, false), recording each
+// instruction. Set a budget on the machine if the program might not stop.
+func (cv *Coverage) Run(c *csirac.CSIRAC) error {
+	for {
+		if err := cv.Step(c); err != nil {
+			if errors.Is(err, csirac.ErrStop) {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// Status is the coverage of a line of source.
+type Status int
+
+const (
+	// NotCode is a line with no instructions: a blank line, a comment, a
+	// directive, or data that was never executed.
+	NotCode Status = iota
+
+	// Covered is a line whose instruction was executed (and, for a
+	// conditional skip, both skipped and didn't skip).
+	Covered
+
+	// Partial is a conditional skip that was executed, but only ever
+	// skipped or only ever didn't.
+	Partial
+
+	// Unreached is a line whose instruction was never executed.
+	Unreached
+)
+
+// String returns the name of the status, which is also its class in HTML
+// reports.
+func (s Status) String() string {
+	switch s {
+	case NotCode:
+		return "notcode"
+	case Covered:
+		return "covered"
+	case Partial:
+		return "partial"
+	case Unreached:
+		return "unreached"
+	}
+	return fmt.Sprintf("Status(%d)", int(s))
+}
+
+// marker returns the character that marks the status in text reports.
+func (s Status) marker() string {
+	return [...]string{NotCode: " ", Covered: "+", Partial: "~", Unreached: "-"}[s]
+}
+
+// Line is the coverage of one line of source.
+type Line struct {
+	Num    int    // line number, from 1
+	Text   string // the source line
+	Status Status
+	Note   string // more about the status, for partly covered lines
+}
+
+// Summary counts the instructions covered.
+type Summary struct {
+	Instructions int // instructions in the program
+	Executed     int // instructions executed
+	Skips        int // conditional skips in the program
+	BothWays     int // conditional skips that both skipped and didn't
+}
+
+func (s Summary) String() string {
+	pct := func(n, d int) float64 {
+		if d == 0 {
+			return 100
+		}
+		return 100 * float64(n) / float64(d)
+	}
+	return fmt.Sprintf("%d of %d instructions executed (%.1f%%), %d of %d conditional skips taken both ways (%.1f%%)",
+		s.Executed, s.Instructions, pct(s.Executed, s.Instructions),
+		s.BothWays, s.Skips, pct(s.BothWays, s.Skips))
+}
+
+// Lines returns the coverage of each line of the program's source. source
+// holds the lines of the source (source[0] is line 1).
+func (cv *Coverage) Lines(prog *csirac.Program, source []string) ([]Line, Summary) {
+	lines := make([]Line, len(source))
+	for i, text := range source {
+		lines[i] = Line{Num: i + 1, Text: text}
+	}
+	var sum Summary
+	for addr, num := range prog.Lines {
+		if num == 0 || num > len(lines) {
+			continue
+		}
+		l := &lines[num-1]
+		data := prog.Data != nil && prog.Data[addr]
+		if data && !cv.Executed[addr] {
+			// Data that was never executed isn't unreached code.
+			continue
+		}
+		sum.Instructions++
+		skip := prog.Words[addr].Dest() == destCS && !data
+		if skip {
+			sum.Skips++
+		}
+		if !cv.Executed[addr] {
+			l.Status = Unreached
+			continue
+		}
+		sum.Executed++
+		l.Status = Covered
+		if !skip {
+			continue
+		}
+		switch {
+		case cv.Skipped[addr] && cv.NotSkipped[addr]:
+			sum.BothWays++
+		case cv.Skipped[addr]:
+			l.Status, l.Note = Partial, "always skipped"
+		default:
+			l.Status, l.Note = Partial, "never skipped"
+		}
+	}
+	return lines, sum
+}
+
+// WriteReport writes the source with each line marked: "+" for covered
+// lines, "~" for conditional skips taken only one way, and "-" for
+// unreached lines, followed by a summary.
+func (cv *Coverage) WriteReport(w io.Writer, prog *csirac.Program, source []string) error {
+	lines, sum := cv.Lines(prog, source)
+	var b strings.Builder
+	for _, l := range lines {
+		fmt.Fprintf(&b, "%5d %s %s", l.Num, l.Status.marker(), l.Text)
+		if l.Note != "" {
+			fmt.Fprintf(&b, "\t(%s)", l.Note)
+		}
+		b.WriteByte('\n')
+	}
+	fmt.Fprintf(&b, "\n%v\n", sum)
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+var htmlReport = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}} - coverage</title>
+<style>
+body { font-family: sans-serif; }
+table { border-collapse: collapse; font-family: monospace; }
+td { padding: 0 0.5em; white-space: pre; }
+td.num { color: #888; text-align: right; }
+tr.covered td.src { background: #cfc; }
+tr.partial td.src { background: #ffc; }
+tr.unreached td.src { background: #fcc; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<p>{{.Summary}}</p>
+<table>
+{{range .Lines}}<tr class="{{.Status}}"><td class="num">{{.Num}}</td><td class="src">{{.Text}}</td><td class="note">{{.Note}}</td></tr>
+{{end}}</table>
+</body>
+</html>
+`))
+
+// WriteHTML writes the coverage as an HTML page, with covered lines in
+// green, conditional skips taken only one way in yellow, and unreached
+// lines in red.
+func (cv *Coverage) WriteHTML(w io.Writer, title string, prog *csirac.Program, source []string) error {
+	lines, sum := cv.Lines(prog, source)
+	return htmlReport.Execute(w, struct {
+		Title   string
+		Summary Summary
+		Lines   []Line
+	}{title, sum, lines})
+}
@@ -0,0 +1,136 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package cover
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/DrJosh9000/CSIRAC"
+)
+
+// The PK sum loop from the programming guide, with a conditional skip that
+// never skips, and a line that is never reached.
+const src = `; sum the data
+	0  0 A  SA	; A = 0
+	8 K  C		; C = 8
+loop:	C  PK		; next command += C
+	data M PA	; A += data[C]
+	PE SC		; C--
+	SC CS		; if C < 0 { skip next }
+	loop-.-1 K PS	; goto loop
+	Z CS		; never skips
+	0 PL T		; stop
+	0 K A		; unreached
+	Z CS		; unreached skip
+	.word 0
+data:	.word 14, 2, 3, 10, 8, 3, 2, 9, 6
+`
+
+func run(t *testing.T) (*Coverage, *csirac.Program, []string) {
+	t.Helper()
+	prog, err := csirac.Assemble(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("Assemble() = %v", err)
+	}
+	c := &csirac.CSIRAC{M: prog.Words}
+	c.K = c.M[0]
+	cv := new(Coverage)
+	if err := cv.Run(c); err != nil {
+		t.Fatalf("cv.Run() = %v", err)
+	}
+	if got, want := c.A, csirac.Word(57); got != want {
+		t.Errorf("after Run: c.A = %d, want %d", got, want)
+	}
+	return cv, prog, strings.Split(src, "\n")
+}
+
+func TestLines(t *testing.T) {
+	cv, prog, source := run(t)
+	lines, sum := cv.Lines(prog, source)
+	want := map[int]Status{
+		1: NotCode, 2: Covered, 3: Covered, 4: Covered, 5: Covered, 6: Covered,
+		7: Covered, 8: Covered, 9: Partial, 10: Covered, 11: Unreached,
+		12: Unreached, 13: NotCode, 14: NotCode,
+	}
+	for num, status := range want {
+		if got := lines[num-1].Status; got != status {
+			t.Errorf("line %d: Status = %v, want %v", num, got, status)
+		}
+	}
+	if got, want := lines[8].Note, "never skipped"; got != want {
+		t.Errorf("line 9: Note = %q, want %q", got, want)
+	}
+	if got, want := sum, (Summary{Instructions: 11, Executed: 9, Skips: 3, BothWays: 1}); got != want {
+		t.Errorf("summary = %+v, want %+v", got, want)
+	}
+}
+
+func TestBudget(t *testing.T) {
+	prog, err := csirac.Assemble(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("Assemble() = %v", err)
+	}
+	c := &csirac.CSIRAC{M: prog.Words, Budget: csirac.Budget{Instructions: 2}}
+	c.K = c.M[0]
+	cv := new(Coverage)
+	var be *csirac.ErrBudgetExceeded
+	if err := cv.Run(c); !errors.As(err, &be) {
+		t.Fatalf("cv.Run() = %v, want *csirac.ErrBudgetExceeded", err)
+	}
+	// The instruction that would have exceeded the budget didn't run.
+	if !cv.Executed[1] || cv.Executed[2] {
+		t.Errorf("Executed[1], Executed[2] = %t, %t, want true, false", cv.Executed[1], cv.Executed[2])
+	}
+}
+
+func TestReports(t *testing.T) {
+	cv, prog, source := run(t)
+
+	var sb strings.Builder
+	if err := cv.WriteReport(&sb, prog, source); err != nil {
+		t.Fatalf("WriteReport() = %v", err)
+	}
+	for _, want := range []string{
+		"    1   ; sum the data\n",
+		"    7 + \tSC CS\t\t; if C < 0 { skip next }\n",
+		"    9 ~ \tZ CS\t\t; never skips\t(never skipped)\n",
+		"   11 - \t0 K A\t\t; unreached\n",
+		"   12 - \tZ CS\t\t; unreached skip\n",
+		"9 of 11 instructions executed (81.8%), 1 of 3 conditional skips taken both ways (33.3%)\n",
+	} {
+		if !strings.Contains(sb.String(), want) {
+			t.Errorf("report doesn't contain %q:\n%s", want, sb.String())
+		}
+	}
+
+	sb.Reset()
+	if err := cv.WriteHTML(&sb, "sum <test>", prog, source); err != nil {
+		t.Fatalf("WriteHTML() = %v", err)
+	}
+	for _, want := range []string{
+		"<title>sum &lt;test&gt; - coverage</title>",
+		`<tr class="partial"><td class="num">9</td><td class="src">	Z CS		; never skips</td><td class="note">never skipped</td></tr>`,
+		`<tr class="unreached"><td class="num">11</td>`,
+		`<td class="src">	SC CS		; if C &lt; 0 { skip next }</td>`,
+	} {
+		if !strings.Contains(sb.String(), want) {
+			t.Errorf("HTML doesn't contain %q:\n%s", want, sb.String())
+		}
+	}
+}
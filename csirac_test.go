@@ -134,3 +134,38 @@ func TestCSIRACLoopPKSum(t *testing.T) {
 		t.Errorf("after Run: c.A = %d, want %d", got, want)
 	}
 }
+
+func TestSignBitSources(t *testing.T) {
+	neg, pos := Word(0b10000_00000_00000_00101), Word(5)
+	tests := []struct {
+		inst     string
+		setup    func(*CSIRAC)
+		signAtP1 bool
+		want     Word
+	}{
+		{" 0  0 SA A", func(c *CSIRAC) { c.A = neg }, false, signBit},
+		{" 0  0 SA A", func(c *CSIRAC) { c.A = neg }, true, 1},
+		{" 0  0 SA A", func(c *CSIRAC) { c.A = pos }, false, 0},
+		{" 0  0 SA A", func(c *CSIRAC) { c.A = pos }, true, 0},
+		{" 0  0 SC A", func(c *CSIRAC) { c.C = neg }, false, signBit},
+		{" 0  0 SC A", func(c *CSIRAC) { c.C = neg }, true, 1},
+		{" 0  0 SC A", func(c *CSIRAC) { c.C = pos }, true, 0},
+		{" 0  3 SD A", func(c *CSIRAC) { c.D[3] = neg }, false, signBit},
+		{" 0  3 SD A", func(c *CSIRAC) { c.D[3] = neg }, true, 1},
+		{" 0  3 SD A", func(c *CSIRAC) { c.D[4] = neg }, true, 0},
+	}
+	for _, test := range tests {
+		c := &CSIRAC{
+			M:        []Word{MustParseInstruction(test.inst), 0},
+			SignAtP1: test.signAtP1,
+		}
+		test.setup(c)
+		c.K = c.M[0]
+		if err := c.Step(); err != nil {
+			t.Fatalf("%q: Step() = %v", test.inst, err)
+		}
+		if got := c.A; got != test.want {
+			t.Errorf("%q with SignAtP1 = %t: A = %v, want %v", test.inst, test.signAtP1, got, test.want)
+		}
+	}
+}
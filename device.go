@@ -0,0 +1,163 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package csirac
+
+import (
+	"fmt"
+	"time"
+)
+
+// EventKind is the kind of input or output in an Event.
+type EventKind int
+
+// Kinds of event.
+const (
+	// EventPrint is a word sent to the teleprinter (OT).
+	EventPrint EventKind = iota
+
+	// EventPunch is a word sent to the tape punch (OP).
+	EventPunch
+
+	// EventSound is a word sent to the loudspeaker (P).
+	EventSound
+
+	// EventTapeRead is a row read from the input tape (I). Word is the value
+	// placed in I, and Addr is the index of the row, or -1 if the reader saw
+	// blank tape past the end (or there is no tape).
+	EventTapeRead
+
+	// EventDrumRead is a word read from a drum (MA to MD). Unit is the drum
+	// (0 for MA to 3 for MD), and Addr is the cell.
+	EventDrumRead
+
+	// EventDrumWrite is a word written to a drum (MA to MD). Unit is the drum
+	// (0 for MA to 3 for MD), and Addr is the cell.
+	EventDrumWrite
+
+	// EventSwitchRead is a word read from a console switch register. Unit is
+	// 0 for NA, 1 for NB, or 2 for IS (which is read along with I).
+	EventSwitchRead
+)
+
+var eventKindNames = [...]string{
+	EventPrint:      "print",
+	EventPunch:      "punch",
+	EventSound:      "sound",
+	EventTapeRead:   "tape read",
+	EventDrumRead:   "drum read",
+	EventDrumWrite:  "drum write",
+	EventSwitchRead: "switch read",
+}
+
+func (k EventKind) String() string {
+	if k < 0 || int(k) >= len(eventKindNames) {
+		return fmt.Sprintf("EventKind(%d)", int(k))
+	}
+	return eventKindNames[k]
+}
+
+// Event is an input or output by the machine: a word sent to an output
+// device, read from the tape reader or a console switch register, or read
+// from or written to a drum.
+type Event struct {
+	Kind EventKind
+	Time time.Duration // the simulated time (see CSIRAC.Time)
+	Unit int           // which drum or switch register, for those kinds
+	Addr int           // the drum cell or tape row, for those kinds
+	Word Word          // the word sent, read or written
+}
+
+// Device receives the events of the machines it is attached to. Devices are
+// called during Step, as each instruction does its input or output. An error
+// from a device doesn't interrupt the instruction, but once it has finished
+// Step returns the error (as an *ErrDevice), which stops Run. A device can
+// return ErrStop to stop the machine as the T destination does.
+type Device interface {
+	Event(c *CSIRAC, e Event) error
+}
+
+// DeviceFunc is a function that is a Device.
+type DeviceFunc func(c *CSIRAC, e Event) error
+
+// Event calls f(c, e).
+func (f DeviceFunc) Event(c *CSIRAC, e Event) error { return f(c, e) }
+
+// ErrDevice is returned by Step when an attached device returned an error.
+// The instruction was completed, so the machine can continue from the next
+// instruction.
+type ErrDevice struct {
+	Event Event // the event the device was given
+	Err   error // the error from the device
+}
+
+func (e *ErrDevice) Error() string {
+	return fmt.Sprintf("device error on %v: %v", e.Event.Kind, e.Err)
+}
+
+func (e *ErrDevice) Unwrap() error { return e.Err }
+
+// attachment is a device attached to a machine.
+type attachment struct {
+	id     int
+	device Device
+}
+
+// Attach attaches a device, which receives every event from now until it is
+// detached by calling the returned function. Devices receive each event in
+// the order they were attached. The same device can be attached more than
+// once, and then receives each event once per attachment.
+func (c *CSIRAC) Attach(d Device) (detach func()) {
+	c.lastID++
+	id := c.lastID
+	// The list is replaced rather than changed, so that devices can attach
+	// and detach devices while handling an event.
+	c.devices = append(c.devices[:len(c.devices):len(c.devices)], attachment{id, d})
+	return func() {
+		for i, a := range c.devices {
+			if a.id == id {
+				devs := make([]attachment, 0, len(c.devices)-1)
+				c.devices = append(append(devs, c.devices[:i]...), c.devices[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// event sends an event to the attached devices, if there are any.
+func (c *CSIRAC) event(kind EventKind, unit, addr int, w Word) {
+	if len(c.devices) > 0 {
+		c.notify(Event{Kind: kind, Time: c.Time(), Unit: unit, Addr: addr, Word: w})
+	}
+}
+
+// notify sends e to each attached device, keeping the first error.
+func (c *CSIRAC) notify(e Event) {
+	for _, a := range c.devices {
+		if err := a.device.Event(c, e); err != nil && c.deviceErr == nil {
+			c.deviceErr = &ErrDevice{Event: e, Err: err}
+		}
+	}
+}
+
+// deviceError returns the first error from a device since it was last
+// called, or err if there was none.
+func (c *CSIRAC) deviceError(err error) error {
+	if c.deviceErr != nil {
+		err, c.deviceErr = c.deviceErr, nil
+	}
+	return err
+}
@@ -0,0 +1,195 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package csirac
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestEvents(t *testing.T) {
+	tests := []struct {
+		inst  string
+		setup func(*CSIRAC)
+		want  []Event
+	}{
+		{" 0  0 A  OT", func(c *CSIRAC) { c.A = 7 }, []Event{{Kind: EventPrint, Word: 7}}},
+		{" 0  0 A  OP", func(c *CSIRAC) { c.A = 8 }, []Event{{Kind: EventPunch, Word: 8}}},
+		{" 0  0 A  P", func(c *CSIRAC) { c.A = 9 }, []Event{{Kind: EventSound, Word: 9}}},
+		{" 0  0 NA Z", func(c *CSIRAC) { c.NA = 10 }, []Event{{Kind: EventSwitchRead, Unit: 0, Word: 10}}},
+		{" 0  0 NB Z", func(c *CSIRAC) { c.NB = 11 }, []Event{{Kind: EventSwitchRead, Unit: 1, Word: 11}}},
+		{
+			" 0  0 I  Z",
+			func(c *CSIRAC) { c.Input, c.IS = &InputTape{Rows: []uint16{1, 12}, Pos: 1}, 2 },
+			[]Event{
+				{Kind: EventTapeRead, Addr: 1, Word: 12},
+				{Kind: EventSwitchRead, Unit: 2, Word: 2},
+			},
+		},
+		{
+			" 0  0 I  Z",
+			func(c *CSIRAC) { c.Input = &InputTape{Rows: []uint16{1}, Pos: 1} },
+			[]Event{
+				{Kind: EventTapeRead, Addr: -1},
+				{Kind: EventSwitchRead, Unit: 2},
+			},
+		},
+		{" 0  3 MA Z", func(c *CSIRAC) { c.MA[3] = 13 }, []Event{{Kind: EventDrumRead, Unit: 0, Addr: 3, Word: 13}}},
+		{" 0  3 MD Z", func(c *CSIRAC) { c.MD[3] = 14 }, []Event{{Kind: EventDrumRead, Unit: 3, Addr: 3, Word: 14}}},
+		{" 0  4 MB MC", func(c *CSIRAC) { c.MB[4] = 15 }, []Event{
+			{Kind: EventDrumRead, Unit: 1, Addr: 4, Word: 15},
+			{Kind: EventDrumWrite, Unit: 2, Addr: 4, Word: 15},
+		}},
+		{" 0  0 A  PA", func(c *CSIRAC) { c.A = 16 }, nil},
+	}
+	for _, test := range tests {
+		c := &CSIRAC{
+			M:            []Word{MustParseInstruction(test.inst), 0},
+			MA:           make([]Word, 8),
+			MB:           make([]Word, 8),
+			MC:           make([]Word, 8),
+			MD:           make([]Word, 8),
+			Instructions: 41,
+		}
+		test.setup(c)
+		c.K = c.M[0]
+		var got recorder
+		c.Attach(&got)
+		if err := c.Step(); err != nil {
+			t.Fatalf("%q: Step() = %v", test.inst, err)
+		}
+		for i := range test.want {
+			test.want[i].Time = 42 * InstructionTime
+		}
+		if !reflect.DeepEqual([]Event(got), test.want) {
+			t.Errorf("%q: events = %+v, want %+v", test.inst, got, test.want)
+		}
+	}
+}
+
+func TestAttachDetach(t *testing.T) {
+	c := &CSIRAC{
+		M: []Word{
+			MustParseInstruction(" 0  0 PL OT"),
+			MustParseInstruction(" 0  0 PL OT"),
+			MustParseInstruction(" 0  0 PL OT"),
+			MustParseInstruction("31 31 K  T"),
+			0,
+		},
+	}
+	c.K = c.M[0]
+	var order []string
+	device := func(name string) Device {
+		return DeviceFunc(func(*CSIRAC, Event) error {
+			order = append(order, name)
+			return nil
+		})
+	}
+	detachA := c.Attach(device("a"))
+	var detachB func()
+	detachB = c.Attach(DeviceFunc(func(*CSIRAC, Event) error {
+		// Detaching during an event takes effect from the next event.
+		order = append(order, "b")
+		detachB()
+		return nil
+	}))
+	c.Attach(device("c"))
+	if err := c.Step(); err != nil {
+		t.Fatalf("Step() = %v", err)
+	}
+	detachA()
+	detachA() // detaching twice does nothing
+	if err := c.Run(0, false); err != nil {
+		t.Fatalf("Run() = %v", err)
+	}
+	if want := []string{"a", "b", "c", "c", "c"}; !reflect.DeepEqual(order, want) {
+		t.Errorf("devices called in order %v, want %v", order, want)
+	}
+}
+
+func TestDeviceErrors(t *testing.T) {
+	errJammed := errors.New("jammed")
+	c := &CSIRAC{
+		M: []Word{
+			MustParseInstruction(" 0  0 PL OP"),
+			MustParseInstruction(" 0  0 PL PA"),
+			MustParseInstruction(" 0  0 PL OT"),
+			MustParseInstruction(" 0  0 PL PA"),
+			0,
+		},
+	}
+	c.K = c.M[0]
+	c.Attach(DeviceFunc(func(_ *CSIRAC, e Event) error {
+		switch e.Kind {
+		case EventPunch:
+			return errJammed
+		case EventPrint:
+			return ErrStop
+		}
+		return nil
+	}))
+
+	// The error stops Run after the instruction that caused it.
+	err := c.Run(0, false)
+	var de *ErrDevice
+	if !errors.As(err, &de) || !errors.Is(err, errJammed) {
+		t.Fatalf("Run() = %v, want *ErrDevice wrapping %v", err, errJammed)
+	}
+	if want := (Event{Kind: EventPunch, Time: InstructionTime, Word: 1}); de.Event != want {
+		t.Errorf("ErrDevice.Event = %+v, want %+v", de.Event, want)
+	}
+	if got, want := c.S.Hi(), Word(1); got != want {
+		t.Errorf("after Run: S = %d, want %d", got, want)
+	}
+
+	// The machine can continue, and a device can stop it like T does.
+	if err := c.Run(0, false); err != nil {
+		t.Errorf("Run() = %v, want nil", err)
+	}
+	if got, want := c.A, Word(1); got != want {
+		t.Errorf("after Run: A = %d, want %d", got, want)
+	}
+	if got, want := c.S.Hi(), Word(3); got != want {
+		t.Errorf("after Run: S = %d, want %d", got, want)
+	}
+}
+
+func TestOutputDevices(t *testing.T) {
+	c := &CSIRAC{
+		M: []Word{
+			MustParseInstruction(" 0  3 K  HU"), // H = 3 (A)
+			MustParseInstruction(" 0  0 HL OT"),
+			MustParseInstruction(" 0  0 HL OP"),
+			MustParseInstruction("31 31 K  T"),
+			0,
+		},
+	}
+	c.K = c.M[0]
+	tp, p := new(Teleprinter), new(Punch)
+	c.Attach(tp)
+	c.Attach(p)
+	if err := c.Run(0, false); err != nil {
+		t.Fatalf("Run() = %v", err)
+	}
+	if got, want := tp.String(), "A"; got != want {
+		t.Errorf("teleprinter printed %q, want %q", got, want)
+	}
+	if got, want := p.Rows, []byte{3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("punched %v, want %v", got, want)
+	}
+}
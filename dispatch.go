@@ -0,0 +1,8203 @@
+// Code generated by gen_dispatch.go; DO NOT EDIT.
+
+package csirac
+
+// dispatch has a function for each combination of source and destination,
+// indexed by the lower half of the instruction. Each one does the same as
+// ReadSource, then incrementing S and fetching the next instruction into K,
+// then WriteDest, but with the source and destination functions called
+// directly (so they can be inlined) instead of through the tables.
+var dispatch = [1024]func(c *CSIRAC, k Word) error{
+	0<<5 | 0:   execMToM,
+	0<<5 | 1:   execMToQ,
+	0<<5 | 2:   execMToOT,
+	0<<5 | 3:   execMToOP,
+	0<<5 | 4:   execMToA,
+	0<<5 | 5:   execMToPA,
+	0<<5 | 6:   execMToSA,
+	0<<5 | 7:   execMToCA,
+	0<<5 | 8:   execMToDA,
+	0<<5 | 9:   execMToNA,
+	0<<5 | 10:  execMToP,
+	0<<5 | 11:  execMToB,
+	0<<5 | 12:  execMToXB,
+	0<<5 | 13:  execMToL,
+	0<<5 | 14:  execMToC,
+	0<<5 | 15:  execMToPC,
+	0<<5 | 16:  execMToSC,
+	0<<5 | 17:  execMToD,
+	0<<5 | 18:  execMToPD,
+	0<<5 | 19:  execMToSD,
+	0<<5 | 20:  execMToZ,
+	0<<5 | 21:  execMToHL,
+	0<<5 | 22:  execMToHU,
+	0<<5 | 23:  execMToS,
+	0<<5 | 24:  execMToPS,
+	0<<5 | 25:  execMToCS,
+	0<<5 | 26:  execMToPK,
+	0<<5 | 27:  execMToMA,
+	0<<5 | 28:  execMToMB,
+	0<<5 | 29:  execMToMC,
+	0<<5 | 30:  execMToMD,
+	0<<5 | 31:  execMToT,
+	1<<5 | 0:   execIToM,
+	1<<5 | 1:   execIToQ,
+	1<<5 | 2:   execIToOT,
+	1<<5 | 3:   execIToOP,
+	1<<5 | 4:   execIToA,
+	1<<5 | 5:   execIToPA,
+	1<<5 | 6:   execIToSA,
+	1<<5 | 7:   execIToCA,
+	1<<5 | 8:   execIToDA,
+	1<<5 | 9:   execIToNA,
+	1<<5 | 10:  execIToP,
+	1<<5 | 11:  execIToB,
+	1<<5 | 12:  execIToXB,
+	1<<5 | 13:  execIToL,
+	1<<5 | 14:  execIToC,
+	1<<5 | 15:  execIToPC,
+	1<<5 | 16:  execIToSC,
+	1<<5 | 17:  execIToD,
+	1<<5 | 18:  execIToPD,
+	1<<5 | 19:  execIToSD,
+	1<<5 | 20:  execIToZ,
+	1<<5 | 21:  execIToHL,
+	1<<5 | 22:  execIToHU,
+	1<<5 | 23:  execIToS,
+	1<<5 | 24:  execIToPS,
+	1<<5 | 25:  execIToCS,
+	1<<5 | 26:  execIToPK,
+	1<<5 | 27:  execIToMA,
+	1<<5 | 28:  execIToMB,
+	1<<5 | 29:  execIToMC,
+	1<<5 | 30:  execIToMD,
+	1<<5 | 31:  execIToT,
+	2<<5 | 0:   execNAToM,
+	2<<5 | 1:   execNAToQ,
+	2<<5 | 2:   execNAToOT,
+	2<<5 | 3:   execNAToOP,
+	2<<5 | 4:   execNAToA,
+	2<<5 | 5:   execNAToPA,
+	2<<5 | 6:   execNAToSA,
+	2<<5 | 7:   execNAToCA,
+	2<<5 | 8:   execNAToDA,
+	2<<5 | 9:   execNAToNA,
+	2<<5 | 10:  execNAToP,
+	2<<5 | 11:  execNAToB,
+	2<<5 | 12:  execNAToXB,
+	2<<5 | 13:  execNAToL,
+	2<<5 | 14:  execNAToC,
+	2<<5 | 15:  execNAToPC,
+	2<<5 | 16:  execNAToSC,
+	2<<5 | 17:  execNAToD,
+	2<<5 | 18:  execNAToPD,
+	2<<5 | 19:  execNAToSD,
+	2<<5 | 20:  execNAToZ,
+	2<<5 | 21:  execNAToHL,
+	2<<5 | 22:  execNAToHU,
+	2<<5 | 23:  execNAToS,
+	2<<5 | 24:  execNAToPS,
+	2<<5 | 25:  execNAToCS,
+	2<<5 | 26:  execNAToPK,
+	2<<5 | 27:  execNAToMA,
+	2<<5 | 28:  execNAToMB,
+	2<<5 | 29:  execNAToMC,
+	2<<5 | 30:  execNAToMD,
+	2<<5 | 31:  execNAToT,
+	3<<5 | 0:   execNBToM,
+	3<<5 | 1:   execNBToQ,
+	3<<5 | 2:   execNBToOT,
+	3<<5 | 3:   execNBToOP,
+	3<<5 | 4:   execNBToA,
+	3<<5 | 5:   execNBToPA,
+	3<<5 | 6:   execNBToSA,
+	3<<5 | 7:   execNBToCA,
+	3<<5 | 8:   execNBToDA,
+	3<<5 | 9:   execNBToNA,
+	3<<5 | 10:  execNBToP,
+	3<<5 | 11:  execNBToB,
+	3<<5 | 12:  execNBToXB,
+	3<<5 | 13:  execNBToL,
+	3<<5 | 14:  execNBToC,
+	3<<5 | 15:  execNBToPC,
+	3<<5 | 16:  execNBToSC,
+	3<<5 | 17:  execNBToD,
+	3<<5 | 18:  execNBToPD,
+	3<<5 | 19:  execNBToSD,
+	3<<5 | 20:  execNBToZ,
+	3<<5 | 21:  execNBToHL,
+	3<<5 | 22:  execNBToHU,
+	3<<5 | 23:  execNBToS,
+	3<<5 | 24:  execNBToPS,
+	3<<5 | 25:  execNBToCS,
+	3<<5 | 26:  execNBToPK,
+	3<<5 | 27:  execNBToMA,
+	3<<5 | 28:  execNBToMB,
+	3<<5 | 29:  execNBToMC,
+	3<<5 | 30:  execNBToMD,
+	3<<5 | 31:  execNBToT,
+	4<<5 | 0:   execAToM,
+	4<<5 | 1:   execAToQ,
+	4<<5 | 2:   execAToOT,
+	4<<5 | 3:   execAToOP,
+	4<<5 | 4:   execAToA,
+	4<<5 | 5:   execAToPA,
+	4<<5 | 6:   execAToSA,
+	4<<5 | 7:   execAToCA,
+	4<<5 | 8:   execAToDA,
+	4<<5 | 9:   execAToNA,
+	4<<5 | 10:  execAToP,
+	4<<5 | 11:  execAToB,
+	4<<5 | 12:  execAToXB,
+	4<<5 | 13:  execAToL,
+	4<<5 | 14:  execAToC,
+	4<<5 | 15:  execAToPC,
+	4<<5 | 16:  execAToSC,
+	4<<5 | 17:  execAToD,
+	4<<5 | 18:  execAToPD,
+	4<<5 | 19:  execAToSD,
+	4<<5 | 20:  execAToZ,
+	4<<5 | 21:  execAToHL,
+	4<<5 | 22:  execAToHU,
+	4<<5 | 23:  execAToS,
+	4<<5 | 24:  execAToPS,
+	4<<5 | 25:  execAToCS,
+	4<<5 | 26:  execAToPK,
+	4<<5 | 27:  execAToMA,
+	4<<5 | 28:  execAToMB,
+	4<<5 | 29:  execAToMC,
+	4<<5 | 30:  execAToMD,
+	4<<5 | 31:  execAToT,
+	5<<5 | 0:   execSAToM,
+	5<<5 | 1:   execSAToQ,
+	5<<5 | 2:   execSAToOT,
+	5<<5 | 3:   execSAToOP,
+	5<<5 | 4:   execSAToA,
+	5<<5 | 5:   execSAToPA,
+	5<<5 | 6:   execSAToSA,
+	5<<5 | 7:   execSAToCA,
+	5<<5 | 8:   execSAToDA,
+	5<<5 | 9:   execSAToNA,
+	5<<5 | 10:  execSAToP,
+	5<<5 | 11:  execSAToB,
+	5<<5 | 12:  execSAToXB,
+	5<<5 | 13:  execSAToL,
+	5<<5 | 14:  execSAToC,
+	5<<5 | 15:  execSAToPC,
+	5<<5 | 16:  execSAToSC,
+	5<<5 | 17:  execSAToD,
+	5<<5 | 18:  execSAToPD,
+	5<<5 | 19:  execSAToSD,
+	5<<5 | 20:  execSAToZ,
+	5<<5 | 21:  execSAToHL,
+	5<<5 | 22:  execSAToHU,
+	5<<5 | 23:  execSAToS,
+	5<<5 | 24:  execSAToPS,
+	5<<5 | 25:  execSAToCS,
+	5<<5 | 26:  execSAToPK,
+	5<<5 | 27:  execSAToMA,
+	5<<5 | 28:  execSAToMB,
+	5<<5 | 29:  execSAToMC,
+	5<<5 | 30:  execSAToMD,
+	5<<5 | 31:  execSAToT,
+	6<<5 | 0:   execHAToM,
+	6<<5 | 1:   execHAToQ,
+	6<<5 | 2:   execHAToOT,
+	6<<5 | 3:   execHAToOP,
+	6<<5 | 4:   execHAToA,
+	6<<5 | 5:   execHAToPA,
+	6<<5 | 6:   execHAToSA,
+	6<<5 | 7:   execHAToCA,
+	6<<5 | 8:   execHAToDA,
+	6<<5 | 9:   execHAToNA,
+	6<<5 | 10:  execHAToP,
+	6<<5 | 11:  execHAToB,
+	6<<5 | 12:  execHAToXB,
+	6<<5 | 13:  execHAToL,
+	6<<5 | 14:  execHAToC,
+	6<<5 | 15:  execHAToPC,
+	6<<5 | 16:  execHAToSC,
+	6<<5 | 17:  execHAToD,
+	6<<5 | 18:  execHAToPD,
+	6<<5 | 19:  execHAToSD,
+	6<<5 | 20:  execHAToZ,
+	6<<5 | 21:  execHAToHL,
+	6<<5 | 22:  execHAToHU,
+	6<<5 | 23:  execHAToS,
+	6<<5 | 24:  execHAToPS,
+	6<<5 | 25:  execHAToCS,
+	6<<5 | 26:  execHAToPK,
+	6<<5 | 27:  execHAToMA,
+	6<<5 | 28:  execHAToMB,
+	6<<5 | 29:  execHAToMC,
+	6<<5 | 30:  execHAToMD,
+	6<<5 | 31:  execHAToT,
+	7<<5 | 0:   execTAToM,
+	7<<5 | 1:   execTAToQ,
+	7<<5 | 2:   execTAToOT,
+	7<<5 | 3:   execTAToOP,
+	7<<5 | 4:   execTAToA,
+	7<<5 | 5:   execTAToPA,
+	7<<5 | 6:   execTAToSA,
+	7<<5 | 7:   execTAToCA,
+	7<<5 | 8:   execTAToDA,
+	7<<5 | 9:   execTAToNA,
+	7<<5 | 10:  execTAToP,
+	7<<5 | 11:  execTAToB,
+	7<<5 | 12:  execTAToXB,
+	7<<5 | 13:  execTAToL,
+	7<<5 | 14:  execTAToC,
+	7<<5 | 15:  execTAToPC,
+	7<<5 | 16:  execTAToSC,
+	7<<5 | 17:  execTAToD,
+	7<<5 | 18:  execTAToPD,
+	7<<5 | 19:  execTAToSD,
+	7<<5 | 20:  execTAToZ,
+	7<<5 | 21:  execTAToHL,
+	7<<5 | 22:  execTAToHU,
+	7<<5 | 23:  execTAToS,
+	7<<5 | 24:  execTAToPS,
+	7<<5 | 25:  execTAToCS,
+	7<<5 | 26:  execTAToPK,
+	7<<5 | 27:  execTAToMA,
+	7<<5 | 28:  execTAToMB,
+	7<<5 | 29:  execTAToMC,
+	7<<5 | 30:  execTAToMD,
+	7<<5 | 31:  execTAToT,
+	8<<5 | 0:   execLAToM,
+	8<<5 | 1:   execLAToQ,
+	8<<5 | 2:   execLAToOT,
+	8<<5 | 3:   execLAToOP,
+	8<<5 | 4:   execLAToA,
+	8<<5 | 5:   execLAToPA,
+	8<<5 | 6:   execLAToSA,
+	8<<5 | 7:   execLAToCA,
+	8<<5 | 8:   execLAToDA,
+	8<<5 | 9:   execLAToNA,
+	8<<5 | 10:  execLAToP,
+	8<<5 | 11:  execLAToB,
+	8<<5 | 12:  execLAToXB,
+	8<<5 | 13:  execLAToL,
+	8<<5 | 14:  execLAToC,
+	8<<5 | 15:  execLAToPC,
+	8<<5 | 16:  execLAToSC,
+	8<<5 | 17:  execLAToD,
+	8<<5 | 18:  execLAToPD,
+	8<<5 | 19:  execLAToSD,
+	8<<5 | 20:  execLAToZ,
+	8<<5 | 21:  execLAToHL,
+	8<<5 | 22:  execLAToHU,
+	8<<5 | 23:  execLAToS,
+	8<<5 | 24:  execLAToPS,
+	8<<5 | 25:  execLAToCS,
+	8<<5 | 26:  execLAToPK,
+	8<<5 | 27:  execLAToMA,
+	8<<5 | 28:  execLAToMB,
+	8<<5 | 29:  execLAToMC,
+	8<<5 | 30:  execLAToMD,
+	8<<5 | 31:  execLAToT,
+	9<<5 | 0:   execCAToM,
+	9<<5 | 1:   execCAToQ,
+	9<<5 | 2:   execCAToOT,
+	9<<5 | 3:   execCAToOP,
+	9<<5 | 4:   execCAToA,
+	9<<5 | 5:   execCAToPA,
+	9<<5 | 6:   execCAToSA,
+	9<<5 | 7:   execCAToCA,
+	9<<5 | 8:   execCAToDA,
+	9<<5 | 9:   execCAToNA,
+	9<<5 | 10:  execCAToP,
+	9<<5 | 11:  execCAToB,
+	9<<5 | 12:  execCAToXB,
+	9<<5 | 13:  execCAToL,
+	9<<5 | 14:  execCAToC,
+	9<<5 | 15:  execCAToPC,
+	9<<5 | 16:  execCAToSC,
+	9<<5 | 17:  execCAToD,
+	9<<5 | 18:  execCAToPD,
+	9<<5 | 19:  execCAToSD,
+	9<<5 | 20:  execCAToZ,
+	9<<5 | 21:  execCAToHL,
+	9<<5 | 22:  execCAToHU,
+	9<<5 | 23:  execCAToS,
+	9<<5 | 24:  execCAToPS,
+	9<<5 | 25:  execCAToCS,
+	9<<5 | 26:  execCAToPK,
+	9<<5 | 27:  execCAToMA,
+	9<<5 | 28:  execCAToMB,
+	9<<5 | 29:  execCAToMC,
+	9<<5 | 30:  execCAToMD,
+	9<<5 | 31:  execCAToT,
+	10<<5 | 0:  execZAToM,
+	10<<5 | 1:  execZAToQ,
+	10<<5 | 2:  execZAToOT,
+	10<<5 | 3:  execZAToOP,
+	10<<5 | 4:  execZAToA,
+	10<<5 | 5:  execZAToPA,
+	10<<5 | 6:  execZAToSA,
+	10<<5 | 7:  execZAToCA,
+	10<<5 | 8:  execZAToDA,
+	10<<5 | 9:  execZAToNA,
+	10<<5 | 10: execZAToP,
+	10<<5 | 11: execZAToB,
+	10<<5 | 12: execZAToXB,
+	10<<5 | 13: execZAToL,
+	10<<5 | 14: execZAToC,
+	10<<5 | 15: execZAToPC,
+	10<<5 | 16: execZAToSC,
+	10<<5 | 17: execZAToD,
+	10<<5 | 18: execZAToPD,
+	10<<5 | 19: execZAToSD,
+	10<<5 | 20: execZAToZ,
+	10<<5 | 21: execZAToHL,
+	10<<5 | 22: execZAToHU,
+	10<<5 | 23: execZAToS,
+	10<<5 | 24: execZAToPS,
+	10<<5 | 25: execZAToCS,
+	10<<5 | 26: execZAToPK,
+	10<<5 | 27: execZAToMA,
+	10<<5 | 28: execZAToMB,
+	10<<5 | 29: execZAToMC,
+	10<<5 | 30: execZAToMD,
+	10<<5 | 31: execZAToT,
+	11<<5 | 0:  execBToM,
+	11<<5 | 1:  execBToQ,
+	11<<5 | 2:  execBToOT,
+	11<<5 | 3:  execBToOP,
+	11<<5 | 4:  execBToA,
+	11<<5 | 5:  execBToPA,
+	11<<5 | 6:  execBToSA,
+	11<<5 | 7:  execBToCA,
+	11<<5 | 8:  execBToDA,
+	11<<5 | 9:  execBToNA,
+	11<<5 | 10: execBToP,
+	11<<5 | 11: execBToB,
+	11<<5 | 12: execBToXB,
+	11<<5 | 13: execBToL,
+	11<<5 | 14: execBToC,
+	11<<5 | 15: execBToPC,
+	11<<5 | 16: execBToSC,
+	11<<5 | 17: execBToD,
+	11<<5 | 18: execBToPD,
+	11<<5 | 19: execBToSD,
+	11<<5 | 20: execBToZ,
+	11<<5 | 21: execBToHL,
+	11<<5 | 22: execBToHU,
+	11<<5 | 23: execBToS,
+	11<<5 | 24: execBToPS,
+	11<<5 | 25: execBToCS,
+	11<<5 | 26: execBToPK,
+	11<<5 | 27: execBToMA,
+	11<<5 | 28: execBToMB,
+	11<<5 | 29: execBToMC,
+	11<<5 | 30: execBToMD,
+	11<<5 | 31: execBToT,
+	12<<5 | 0:  execRToM,
+	12<<5 | 1:  execRToQ,
+	12<<5 | 2:  execRToOT,
+	12<<5 | 3:  execRToOP,
+	12<<5 | 4:  execRToA,
+	12<<5 | 5:  execRToPA,
+	12<<5 | 6:  execRToSA,
+	12<<5 | 7:  execRToCA,
+	12<<5 | 8:  execRToDA,
+	12<<5 | 9:  execRToNA,
+	12<<5 | 10: execRToP,
+	12<<5 | 11: execRToB,
+	12<<5 | 12: execRToXB,
+	12<<5 | 13: execRToL,
+	12<<5 | 14: execRToC,
+	12<<5 | 15: execRToPC,
+	12<<5 | 16: execRToSC,
+	12<<5 | 17: execRToD,
+	12<<5 | 18: execRToPD,
+	12<<5 | 19: execRToSD,
+	12<<5 | 20: execRToZ,
+	12<<5 | 21: execRToHL,
+	12<<5 | 22: execRToHU,
+	12<<5 | 23: execRToS,
+	12<<5 | 24: execRToPS,
+	12<<5 | 25: execRToCS,
+	12<<5 | 26: execRToPK,
+	12<<5 | 27: execRToMA,
+	12<<5 | 28: execRToMB,
+	12<<5 | 29: execRToMC,
+	12<<5 | 30: execRToMD,
+	12<<5 | 31: execRToT,
+	13<<5 | 0:  execRBToM,
+	13<<5 | 1:  execRBToQ,
+	13<<5 | 2:  execRBToOT,
+	13<<5 | 3:  execRBToOP,
+	13<<5 | 4:  execRBToA,
+	13<<5 | 5:  execRBToPA,
+	13<<5 | 6:  execRBToSA,
+	13<<5 | 7:  execRBToCA,
+	13<<5 | 8:  execRBToDA,
+	13<<5 | 9:  execRBToNA,
+	13<<5 | 10: execRBToP,
+	13<<5 | 11: execRBToB,
+	13<<5 | 12: execRBToXB,
+	13<<5 | 13: execRBToL,
+	13<<5 | 14: execRBToC,
+	13<<5 | 15: execRBToPC,
+	13<<5 | 16: execRBToSC,
+	13<<5 | 17: execRBToD,
+	13<<5 | 18: execRBToPD,
+	13<<5 | 19: execRBToSD,
+	13<<5 | 20: execRBToZ,
+	13<<5 | 21: execRBToHL,
+	13<<5 | 22: execRBToHU,
+	13<<5 | 23: execRBToS,
+	13<<5 | 24: execRBToPS,
+	13<<5 | 25: execRBToCS,
+	13<<5 | 26: execRBToPK,
+	13<<5 | 27: execRBToMA,
+	13<<5 | 28: execRBToMB,
+	13<<5 | 29: execRBToMC,
+	13<<5 | 30: execRBToMD,
+	13<<5 | 31: execRBToT,
+	14<<5 | 0:  execCToM,
+	14<<5 | 1:  execCToQ,
+	14<<5 | 2:  execCToOT,
+	14<<5 | 3:  execCToOP,
+	14<<5 | 4:  execCToA,
+	14<<5 | 5:  execCToPA,
+	14<<5 | 6:  execCToSA,
+	14<<5 | 7:  execCToCA,
+	14<<5 | 8:  execCToDA,
+	14<<5 | 9:  execCToNA,
+	14<<5 | 10: execCToP,
+	14<<5 | 11: execCToB,
+	14<<5 | 12: execCToXB,
+	14<<5 | 13: execCToL,
+	14<<5 | 14: execCToC,
+	14<<5 | 15: execCToPC,
+	14<<5 | 16: execCToSC,
+	14<<5 | 17: execCToD,
+	14<<5 | 18: execCToPD,
+	14<<5 | 19: execCToSD,
+	14<<5 | 20: execCToZ,
+	14<<5 | 21: execCToHL,
+	14<<5 | 22: execCToHU,
+	14<<5 | 23: execCToS,
+	14<<5 | 24: execCToPS,
+	14<<5 | 25: execCToCS,
+	14<<5 | 26: execCToPK,
+	14<<5 | 27: execCToMA,
+	14<<5 | 28: execCToMB,
+	14<<5 | 29: execCToMC,
+	14<<5 | 30: execCToMD,
+	14<<5 | 31: execCToT,
+	15<<5 | 0:  execSCToM,
+	15<<5 | 1:  execSCToQ,
+	15<<5 | 2:  execSCToOT,
+	15<<5 | 3:  execSCToOP,
+	15<<5 | 4:  execSCToA,
+	15<<5 | 5:  execSCToPA,
+	15<<5 | 6:  execSCToSA,
+	15<<5 | 7:  execSCToCA,
+	15<<5 | 8:  execSCToDA,
+	15<<5 | 9:  execSCToNA,
+	15<<5 | 10: execSCToP,
+	15<<5 | 11: execSCToB,
+	15<<5 | 12: execSCToXB,
+	15<<5 | 13: execSCToL,
+	15<<5 | 14: execSCToC,
+	15<<5 | 15: execSCToPC,
+	15<<5 | 16: execSCToSC,
+	15<<5 | 17: execSCToD,
+	15<<5 | 18: execSCToPD,
+	15<<5 | 19: execSCToSD,
+	15<<5 | 20: execSCToZ,
+	15<<5 | 21: execSCToHL,
+	15<<5 | 22: execSCToHU,
+	15<<5 | 23: execSCToS,
+	15<<5 | 24: execSCToPS,
+	15<<5 | 25: execSCToCS,
+	15<<5 | 26: execSCToPK,
+	15<<5 | 27: execSCToMA,
+	15<<5 | 28: execSCToMB,
+	15<<5 | 29: execSCToMC,
+	15<<5 | 30: execSCToMD,
+	15<<5 | 31: execSCToT,
+	16<<5 | 0:  execRCToM,
+	16<<5 | 1:  execRCToQ,
+	16<<5 | 2:  execRCToOT,
+	16<<5 | 3:  execRCToOP,
+	16<<5 | 4:  execRCToA,
+	16<<5 | 5:  execRCToPA,
+	16<<5 | 6:  execRCToSA,
+	16<<5 | 7:  execRCToCA,
+	16<<5 | 8:  execRCToDA,
+	16<<5 | 9:  execRCToNA,
+	16<<5 | 10: execRCToP,
+	16<<5 | 11: execRCToB,
+	16<<5 | 12: execRCToXB,
+	16<<5 | 13: execRCToL,
+	16<<5 | 14: execRCToC,
+	16<<5 | 15: execRCToPC,
+	16<<5 | 16: execRCToSC,
+	16<<5 | 17: execRCToD,
+	16<<5 | 18: execRCToPD,
+	16<<5 | 19: execRCToSD,
+	16<<5 | 20: execRCToZ,
+	16<<5 | 21: execRCToHL,
+	16<<5 | 22: execRCToHU,
+	16<<5 | 23: execRCToS,
+	16<<5 | 24: execRCToPS,
+	16<<5 | 25: execRCToCS,
+	16<<5 | 26: execRCToPK,
+	16<<5 | 27: execRCToMA,
+	16<<5 | 28: execRCToMB,
+	16<<5 | 29: execRCToMC,
+	16<<5 | 30: execRCToMD,
+	16<<5 | 31: execRCToT,
+	17<<5 | 0:  execDToM,
+	17<<5 | 1:  execDToQ,
+	17<<5 | 2:  execDToOT,
+	17<<5 | 3:  execDToOP,
+	17<<5 | 4:  execDToA,
+	17<<5 | 5:  execDToPA,
+	17<<5 | 6:  execDToSA,
+	17<<5 | 7:  execDToCA,
+	17<<5 | 8:  execDToDA,
+	17<<5 | 9:  execDToNA,
+	17<<5 | 10: execDToP,
+	17<<5 | 11: execDToB,
+	17<<5 | 12: execDToXB,
+	17<<5 | 13: execDToL,
+	17<<5 | 14: execDToC,
+	17<<5 | 15: execDToPC,
+	17<<5 | 16: execDToSC,
+	17<<5 | 17: execDToD,
+	17<<5 | 18: execDToPD,
+	17<<5 | 19: execDToSD,
+	17<<5 | 20: execDToZ,
+	17<<5 | 21: execDToHL,
+	17<<5 | 22: execDToHU,
+	17<<5 | 23: execDToS,
+	17<<5 | 24: execDToPS,
+	17<<5 | 25: execDToCS,
+	17<<5 | 26: execDToPK,
+	17<<5 | 27: execDToMA,
+	17<<5 | 28: execDToMB,
+	17<<5 | 29: execDToMC,
+	17<<5 | 30: execDToMD,
+	17<<5 | 31: execDToT,
+	18<<5 | 0:  execSDToM,
+	18<<5 | 1:  execSDToQ,
+	18<<5 | 2:  execSDToOT,
+	18<<5 | 3:  execSDToOP,
+	18<<5 | 4:  execSDToA,
+	18<<5 | 5:  execSDToPA,
+	18<<5 | 6:  execSDToSA,
+	18<<5 | 7:  execSDToCA,
+	18<<5 | 8:  execSDToDA,
+	18<<5 | 9:  execSDToNA,
+	18<<5 | 10: execSDToP,
+	18<<5 | 11: execSDToB,
+	18<<5 | 12: execSDToXB,
+	18<<5 | 13: execSDToL,
+	18<<5 | 14: execSDToC,
+	18<<5 | 15: execSDToPC,
+	18<<5 | 16: execSDToSC,
+	18<<5 | 17: execSDToD,
+	18<<5 | 18: execSDToPD,
+	18<<5 | 19: execSDToSD,
+	18<<5 | 20: execSDToZ,
+	18<<5 | 21: execSDToHL,
+	18<<5 | 22: execSDToHU,
+	18<<5 | 23: execSDToS,
+	18<<5 | 24: execSDToPS,
+	18<<5 | 25: execSDToCS,
+	18<<5 | 26: execSDToPK,
+	18<<5 | 27: execSDToMA,
+	18<<5 | 28: execSDToMB,
+	18<<5 | 29: execSDToMC,
+	18<<5 | 30: execSDToMD,
+	18<<5 | 31: execSDToT,
+	19<<5 | 0:  execRDToM,
+	19<<5 | 1:  execRDToQ,
+	19<<5 | 2:  execRDToOT,
+	19<<5 | 3:  execRDToOP,
+	19<<5 | 4:  execRDToA,
+	19<<5 | 5:  execRDToPA,
+	19<<5 | 6:  execRDToSA,
+	19<<5 | 7:  execRDToCA,
+	19<<5 | 8:  execRDToDA,
+	19<<5 | 9:  execRDToNA,
+	19<<5 | 10: execRDToP,
+	19<<5 | 11: execRDToB,
+	19<<5 | 12: execRDToXB,
+	19<<5 | 13: execRDToL,
+	19<<5 | 14: execRDToC,
+	19<<5 | 15: execRDToPC,
+	19<<5 | 16: execRDToSC,
+	19<<5 | 17: execRDToD,
+	19<<5 | 18: execRDToPD,
+	19<<5 | 19: execRDToSD,
+	19<<5 | 20: execRDToZ,
+	19<<5 | 21: execRDToHL,
+	19<<5 | 22: execRDToHU,
+	19<<5 | 23: execRDToS,
+	19<<5 | 24: execRDToPS,
+	19<<5 | 25: execRDToCS,
+	19<<5 | 26: execRDToPK,
+	19<<5 | 27: execRDToMA,
+	19<<5 | 28: execRDToMB,
+	19<<5 | 29: execRDToMC,
+	19<<5 | 30: execRDToMD,
+	19<<5 | 31: execRDToT,
+	20<<5 | 0:  execZToM,
+	20<<5 | 1:  execZToQ,
+	20<<5 | 2:  execZToOT,
+	20<<5 | 3:  execZToOP,
+	20<<5 | 4:  execZToA,
+	20<<5 | 5:  execZToPA,
+	20<<5 | 6:  execZToSA,
+	20<<5 | 7:  execZToCA,
+	20<<5 | 8:  execZToDA,
+	20<<5 | 9:  execZToNA,
+	20<<5 | 10: execZToP,
+	20<<5 | 11: execZToB,
+	20<<5 | 12: execZToXB,
+	20<<5 | 13: execZToL,
+	20<<5 | 14: execZToC,
+	20<<5 | 15: execZToPC,
+	20<<5 | 16: execZToSC,
+	20<<5 | 17: execZToD,
+	20<<5 | 18: execZToPD,
+	20<<5 | 19: execZToSD,
+	20<<5 | 20: execZToZ,
+	20<<5 | 21: execZToHL,
+	20<<5 | 22: execZToHU,
+	20<<5 | 23: execZToS,
+	20<<5 | 24: execZToPS,
+	20<<5 | 25: execZToCS,
+	20<<5 | 26: execZToPK,
+	20<<5 | 27: execZToMA,
+	20<<5 | 28: execZToMB,
+	20<<5 | 29: execZToMC,
+	20<<5 | 30: execZToMD,
+	20<<5 | 31: execZToT,
+	21<<5 | 0:  execHLToM,
+	21<<5 | 1:  execHLToQ,
+	21<<5 | 2:  execHLToOT,
+	21<<5 | 3:  execHLToOP,
+	21<<5 | 4:  execHLToA,
+	21<<5 | 5:  execHLToPA,
+	21<<5 | 6:  execHLToSA,
+	21<<5 | 7:  execHLToCA,
+	21<<5 | 8:  execHLToDA,
+	21<<5 | 9:  execHLToNA,
+	21<<5 | 10: execHLToP,
+	21<<5 | 11: execHLToB,
+	21<<5 | 12: execHLToXB,
+	21<<5 | 13: execHLToL,
+	21<<5 | 14: execHLToC,
+	21<<5 | 15: execHLToPC,
+	21<<5 | 16: execHLToSC,
+	21<<5 | 17: execHLToD,
+	21<<5 | 18: execHLToPD,
+	21<<5 | 19: execHLToSD,
+	21<<5 | 20: execHLToZ,
+	21<<5 | 21: execHLToHL,
+	21<<5 | 22: execHLToHU,
+	21<<5 | 23: execHLToS,
+	21<<5 | 24: execHLToPS,
+	21<<5 | 25: execHLToCS,
+	21<<5 | 26: execHLToPK,
+	21<<5 | 27: execHLToMA,
+	21<<5 | 28: execHLToMB,
+	21<<5 | 29: execHLToMC,
+	21<<5 | 30: execHLToMD,
+	21<<5 | 31: execHLToT,
+	22<<5 | 0:  execHUToM,
+	22<<5 | 1:  execHUToQ,
+	22<<5 | 2:  execHUToOT,
+	22<<5 | 3:  execHUToOP,
+	22<<5 | 4:  execHUToA,
+	22<<5 | 5:  execHUToPA,
+	22<<5 | 6:  execHUToSA,
+	22<<5 | 7:  execHUToCA,
+	22<<5 | 8:  execHUToDA,
+	22<<5 | 9:  execHUToNA,
+	22<<5 | 10: execHUToP,
+	22<<5 | 11: execHUToB,
+	22<<5 | 12: execHUToXB,
+	22<<5 | 13: execHUToL,
+	22<<5 | 14: execHUToC,
+	22<<5 | 15: execHUToPC,
+	22<<5 | 16: execHUToSC,
+	22<<5 | 17: execHUToD,
+	22<<5 | 18: execHUToPD,
+	22<<5 | 19: execHUToSD,
+	22<<5 | 20: execHUToZ,
+	22<<5 | 21: execHUToHL,
+	22<<5 | 22: execHUToHU,
+	22<<5 | 23: execHUToS,
+	22<<5 | 24: execHUToPS,
+	22<<5 | 25: execHUToCS,
+	22<<5 | 26: execHUToPK,
+	22<<5 | 27: execHUToMA,
+	22<<5 | 28: execHUToMB,
+	22<<5 | 29: execHUToMC,
+	22<<5 | 30: execHUToMD,
+	22<<5 | 31: execHUToT,
+	23<<5 | 0:  execSToM,
+	23<<5 | 1:  execSToQ,
+	23<<5 | 2:  execSToOT,
+	23<<5 | 3:  execSToOP,
+	23<<5 | 4:  execSToA,
+	23<<5 | 5:  execSToPA,
+	23<<5 | 6:  execSToSA,
+	23<<5 | 7:  execSToCA,
+	23<<5 | 8:  execSToDA,
+	23<<5 | 9:  execSToNA,
+	23<<5 | 10: execSToP,
+	23<<5 | 11: execSToB,
+	23<<5 | 12: execSToXB,
+	23<<5 | 13: execSToL,
+	23<<5 | 14: execSToC,
+	23<<5 | 15: execSToPC,
+	23<<5 | 16: execSToSC,
+	23<<5 | 17: execSToD,
+	23<<5 | 18: execSToPD,
+	23<<5 | 19: execSToSD,
+	23<<5 | 20: execSToZ,
+	23<<5 | 21: execSToHL,
+	23<<5 | 22: execSToHU,
+	23<<5 | 23: execSToS,
+	23<<5 | 24: execSToPS,
+	23<<5 | 25: execSToCS,
+	23<<5 | 26: execSToPK,
+	23<<5 | 27: execSToMA,
+	23<<5 | 28: execSToMB,
+	23<<5 | 29: execSToMC,
+	23<<5 | 30: execSToMD,
+	23<<5 | 31: execSToT,
+	24<<5 | 0:  execPEToM,
+	24<<5 | 1:  execPEToQ,
+	24<<5 | 2:  execPEToOT,
+	24<<5 | 3:  execPEToOP,
+	24<<5 | 4:  execPEToA,
+	24<<5 | 5:  execPEToPA,
+	24<<5 | 6:  execPEToSA,
+	24<<5 | 7:  execPEToCA,
+	24<<5 | 8:  execPEToDA,
+	24<<5 | 9:  execPEToNA,
+	24<<5 | 10: execPEToP,
+	24<<5 | 11: execPEToB,
+	24<<5 | 12: execPEToXB,
+	24<<5 | 13: execPEToL,
+	24<<5 | 14: execPEToC,
+	24<<5 | 15: execPEToPC,
+	24<<5 | 16: execPEToSC,
+	24<<5 | 17: execPEToD,
+	24<<5 | 18: execPEToPD,
+	24<<5 | 19: execPEToSD,
+	24<<5 | 20: execPEToZ,
+	24<<5 | 21: execPEToHL,
+	24<<5 | 22: execPEToHU,
+	24<<5 | 23: execPEToS,
+	24<<5 | 24: execPEToPS,
+	24<<5 | 25: execPEToCS,
+	24<<5 | 26: execPEToPK,
+	24<<5 | 27: execPEToMA,
+	24<<5 | 28: execPEToMB,
+	24<<5 | 29: execPEToMC,
+	24<<5 | 30: execPEToMD,
+	24<<5 | 31: execPEToT,
+	25<<5 | 0:  execPLToM,
+	25<<5 | 1:  execPLToQ,
+	25<<5 | 2:  execPLToOT,
+	25<<5 | 3:  execPLToOP,
+	25<<5 | 4:  execPLToA,
+	25<<5 | 5:  execPLToPA,
+	25<<5 | 6:  execPLToSA,
+	25<<5 | 7:  execPLToCA,
+	25<<5 | 8:  execPLToDA,
+	25<<5 | 9:  execPLToNA,
+	25<<5 | 10: execPLToP,
+	25<<5 | 11: execPLToB,
+	25<<5 | 12: execPLToXB,
+	25<<5 | 13: execPLToL,
+	25<<5 | 14: execPLToC,
+	25<<5 | 15: execPLToPC,
+	25<<5 | 16: execPLToSC,
+	25<<5 | 17: execPLToD,
+	25<<5 | 18: execPLToPD,
+	25<<5 | 19: execPLToSD,
+	25<<5 | 20: execPLToZ,
+	25<<5 | 21: execPLToHL,
+	25<<5 | 22: execPLToHU,
+	25<<5 | 23: execPLToS,
+	25<<5 | 24: execPLToPS,
+	25<<5 | 25: execPLToCS,
+	25<<5 | 26: execPLToPK,
+	25<<5 | 27: execPLToMA,
+	25<<5 | 28: execPLToMB,
+	25<<5 | 29: execPLToMC,
+	25<<5 | 30: execPLToMD,
+	25<<5 | 31: execPLToT,
+	26<<5 | 0:  execKToM,
+	26<<5 | 1:  execKToQ,
+	26<<5 | 2:  execKToOT,
+	26<<5 | 3:  execKToOP,
+	26<<5 | 4:  execKToA,
+	26<<5 | 5:  execKToPA,
+	26<<5 | 6:  execKToSA,
+	26<<5 | 7:  execKToCA,
+	26<<5 | 8:  execKToDA,
+	26<<5 | 9:  execKToNA,
+	26<<5 | 10: execKToP,
+	26<<5 | 11: execKToB,
+	26<<5 | 12: execKToXB,
+	26<<5 | 13: execKToL,
+	26<<5 | 14: execKToC,
+	26<<5 | 15: execKToPC,
+	26<<5 | 16: execKToSC,
+	26<<5 | 17: execKToD,
+	26<<5 | 18: execKToPD,
+	26<<5 | 19: execKToSD,
+	26<<5 | 20: execKToZ,
+	26<<5 | 21: execKToHL,
+	26<<5 | 22: execKToHU,
+	26<<5 | 23: execKToS,
+	26<<5 | 24: execKToPS,
+	26<<5 | 25: execKToCS,
+	26<<5 | 26: execKToPK,
+	26<<5 | 27: execKToMA,
+	26<<5 | 28: execKToMB,
+	26<<5 | 29: execKToMC,
+	26<<5 | 30: execKToMD,
+	26<<5 | 31: execKToT,
+	27<<5 | 0:  execMAToM,
+	27<<5 | 1:  execMAToQ,
+	27<<5 | 2:  execMAToOT,
+	27<<5 | 3:  execMAToOP,
+	27<<5 | 4:  execMAToA,
+	27<<5 | 5:  execMAToPA,
+	27<<5 | 6:  execMAToSA,
+	27<<5 | 7:  execMAToCA,
+	27<<5 | 8:  execMAToDA,
+	27<<5 | 9:  execMAToNA,
+	27<<5 | 10: execMAToP,
+	27<<5 | 11: execMAToB,
+	27<<5 | 12: execMAToXB,
+	27<<5 | 13: execMAToL,
+	27<<5 | 14: execMAToC,
+	27<<5 | 15: execMAToPC,
+	27<<5 | 16: execMAToSC,
+	27<<5 | 17: execMAToD,
+	27<<5 | 18: execMAToPD,
+	27<<5 | 19: execMAToSD,
+	27<<5 | 20: execMAToZ,
+	27<<5 | 21: execMAToHL,
+	27<<5 | 22: execMAToHU,
+	27<<5 | 23: execMAToS,
+	27<<5 | 24: execMAToPS,
+	27<<5 | 25: execMAToCS,
+	27<<5 | 26: execMAToPK,
+	27<<5 | 27: execMAToMA,
+	27<<5 | 28: execMAToMB,
+	27<<5 | 29: execMAToMC,
+	27<<5 | 30: execMAToMD,
+	27<<5 | 31: execMAToT,
+	28<<5 | 0:  execMBToM,
+	28<<5 | 1:  execMBToQ,
+	28<<5 | 2:  execMBToOT,
+	28<<5 | 3:  execMBToOP,
+	28<<5 | 4:  execMBToA,
+	28<<5 | 5:  execMBToPA,
+	28<<5 | 6:  execMBToSA,
+	28<<5 | 7:  execMBToCA,
+	28<<5 | 8:  execMBToDA,
+	28<<5 | 9:  execMBToNA,
+	28<<5 | 10: execMBToP,
+	28<<5 | 11: execMBToB,
+	28<<5 | 12: execMBToXB,
+	28<<5 | 13: execMBToL,
+	28<<5 | 14: execMBToC,
+	28<<5 | 15: execMBToPC,
+	28<<5 | 16: execMBToSC,
+	28<<5 | 17: execMBToD,
+	28<<5 | 18: execMBToPD,
+	28<<5 | 19: execMBToSD,
+	28<<5 | 20: execMBToZ,
+	28<<5 | 21: execMBToHL,
+	28<<5 | 22: execMBToHU,
+	28<<5 | 23: execMBToS,
+	28<<5 | 24: execMBToPS,
+	28<<5 | 25: execMBToCS,
+	28<<5 | 26: execMBToPK,
+	28<<5 | 27: execMBToMA,
+	28<<5 | 28: execMBToMB,
+	28<<5 | 29: execMBToMC,
+	28<<5 | 30: execMBToMD,
+	28<<5 | 31: execMBToT,
+	29<<5 | 0:  execMCToM,
+	29<<5 | 1:  execMCToQ,
+	29<<5 | 2:  execMCToOT,
+	29<<5 | 3:  execMCToOP,
+	29<<5 | 4:  execMCToA,
+	29<<5 | 5:  execMCToPA,
+	29<<5 | 6:  execMCToSA,
+	29<<5 | 7:  execMCToCA,
+	29<<5 | 8:  execMCToDA,
+	29<<5 | 9:  execMCToNA,
+	29<<5 | 10: execMCToP,
+	29<<5 | 11: execMCToB,
+	29<<5 | 12: execMCToXB,
+	29<<5 | 13: execMCToL,
+	29<<5 | 14: execMCToC,
+	29<<5 | 15: execMCToPC,
+	29<<5 | 16: execMCToSC,
+	29<<5 | 17: execMCToD,
+	29<<5 | 18: execMCToPD,
+	29<<5 | 19: execMCToSD,
+	29<<5 | 20: execMCToZ,
+	29<<5 | 21: execMCToHL,
+	29<<5 | 22: execMCToHU,
+	29<<5 | 23: execMCToS,
+	29<<5 | 24: execMCToPS,
+	29<<5 | 25: execMCToCS,
+	29<<5 | 26: execMCToPK,
+	29<<5 | 27: execMCToMA,
+	29<<5 | 28: execMCToMB,
+	29<<5 | 29: execMCToMC,
+	29<<5 | 30: execMCToMD,
+	29<<5 | 31: execMCToT,
+	30<<5 | 0:  execMDToM,
+	30<<5 | 1:  execMDToQ,
+	30<<5 | 2:  execMDToOT,
+	30<<5 | 3:  execMDToOP,
+	30<<5 | 4:  execMDToA,
+	30<<5 | 5:  execMDToPA,
+	30<<5 | 6:  execMDToSA,
+	30<<5 | 7:  execMDToCA,
+	30<<5 | 8:  execMDToDA,
+	30<<5 | 9:  execMDToNA,
+	30<<5 | 10: execMDToP,
+	30<<5 | 11: execMDToB,
+	30<<5 | 12: execMDToXB,
+	30<<5 | 13: execMDToL,
+	30<<5 | 14: execMDToC,
+	30<<5 | 15: execMDToPC,
+	30<<5 | 16: execMDToSC,
+	30<<5 | 17: execMDToD,
+	30<<5 | 18: execMDToPD,
+	30<<5 | 19: execMDToSD,
+	30<<5 | 20: execMDToZ,
+	30<<5 | 21: execMDToHL,
+	30<<5 | 22: execMDToHU,
+	30<<5 | 23: execMDToS,
+	30<<5 | 24: execMDToPS,
+	30<<5 | 25: execMDToCS,
+	30<<5 | 26: execMDToPK,
+	30<<5 | 27: execMDToMA,
+	30<<5 | 28: execMDToMB,
+	30<<5 | 29: execMDToMC,
+	30<<5 | 30: execMDToMD,
+	30<<5 | 31: execMDToT,
+	31<<5 | 0:  execPSToM,
+	31<<5 | 1:  execPSToQ,
+	31<<5 | 2:  execPSToOT,
+	31<<5 | 3:  execPSToOP,
+	31<<5 | 4:  execPSToA,
+	31<<5 | 5:  execPSToPA,
+	31<<5 | 6:  execPSToSA,
+	31<<5 | 7:  execPSToCA,
+	31<<5 | 8:  execPSToDA,
+	31<<5 | 9:  execPSToNA,
+	31<<5 | 10: execPSToP,
+	31<<5 | 11: execPSToB,
+	31<<5 | 12: execPSToXB,
+	31<<5 | 13: execPSToL,
+	31<<5 | 14: execPSToC,
+	31<<5 | 15: execPSToPC,
+	31<<5 | 16: execPSToSC,
+	31<<5 | 17: execPSToD,
+	31<<5 | 18: execPSToPD,
+	31<<5 | 19: execPSToSD,
+	31<<5 | 20: execPSToZ,
+	31<<5 | 21: execPSToHL,
+	31<<5 | 22: execPSToHU,
+	31<<5 | 23: execPSToS,
+	31<<5 | 24: execPSToPS,
+	31<<5 | 25: execPSToCS,
+	31<<5 | 26: execPSToPK,
+	31<<5 | 27: execPSToMA,
+	31<<5 | 28: execPSToMB,
+	31<<5 | 29: execPSToMC,
+	31<<5 | 30: execPSToMD,
+	31<<5 | 31: execPSToT,
+}
+
+func execMToM(c *CSIRAC, k Word) error {
+	src := srcM(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstM(c, k, src)
+}
+
+func execMToQ(c *CSIRAC, k Word) error {
+	src := srcM(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstQ(c, k, src)
+}
+
+func execMToOT(c *CSIRAC, k Word) error {
+	src := srcM(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOT(c, k, src)
+}
+
+func execMToOP(c *CSIRAC, k Word) error {
+	src := srcM(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOP(c, k, src)
+}
+
+func execMToA(c *CSIRAC, k Word) error {
+	src := srcM(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstA(c, k, src)
+}
+
+func execMToPA(c *CSIRAC, k Word) error {
+	src := srcM(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPA(c, k, src)
+}
+
+func execMToSA(c *CSIRAC, k Word) error {
+	src := srcM(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSA(c, k, src)
+}
+
+func execMToCA(c *CSIRAC, k Word) error {
+	src := srcM(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCA(c, k, src)
+}
+
+func execMToDA(c *CSIRAC, k Word) error {
+	src := srcM(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstDA(c, k, src)
+}
+
+func execMToNA(c *CSIRAC, k Word) error {
+	src := srcM(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstNA(c, k, src)
+}
+
+func execMToP(c *CSIRAC, k Word) error {
+	src := srcM(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstP(c, k, src)
+}
+
+func execMToB(c *CSIRAC, k Word) error {
+	src := srcM(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstB(c, k, src)
+}
+
+func execMToXB(c *CSIRAC, k Word) error {
+	src := srcM(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstXB(c, k, src)
+}
+
+func execMToL(c *CSIRAC, k Word) error {
+	src := srcM(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstL(c, k, src)
+}
+
+func execMToC(c *CSIRAC, k Word) error {
+	src := srcM(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstC(c, k, src)
+}
+
+func execMToPC(c *CSIRAC, k Word) error {
+	src := srcM(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPC(c, k, src)
+}
+
+func execMToSC(c *CSIRAC, k Word) error {
+	src := srcM(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSC(c, k, src)
+}
+
+func execMToD(c *CSIRAC, k Word) error {
+	src := srcM(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstD(c, k, src)
+}
+
+func execMToPD(c *CSIRAC, k Word) error {
+	src := srcM(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPD(c, k, src)
+}
+
+func execMToSD(c *CSIRAC, k Word) error {
+	src := srcM(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSD(c, k, src)
+}
+
+func execMToZ(c *CSIRAC, k Word) error {
+	src := srcM(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstZ(c, k, src)
+}
+
+func execMToHL(c *CSIRAC, k Word) error {
+	src := srcM(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHL(c, k, src)
+}
+
+func execMToHU(c *CSIRAC, k Word) error {
+	src := srcM(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHU(c, k, src)
+}
+
+func execMToS(c *CSIRAC, k Word) error {
+	src := srcM(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstS(c, k, src)
+}
+
+func execMToPS(c *CSIRAC, k Word) error {
+	src := srcM(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPS(c, k, src)
+}
+
+func execMToCS(c *CSIRAC, k Word) error {
+	src := srcM(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCS(c, k, src)
+}
+
+func execMToPK(c *CSIRAC, k Word) error {
+	src := srcM(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPK(c, k, src)
+}
+
+func execMToMA(c *CSIRAC, k Word) error {
+	src := srcM(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMA(c, k, src)
+}
+
+func execMToMB(c *CSIRAC, k Word) error {
+	src := srcM(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMB(c, k, src)
+}
+
+func execMToMC(c *CSIRAC, k Word) error {
+	src := srcM(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMC(c, k, src)
+}
+
+func execMToMD(c *CSIRAC, k Word) error {
+	src := srcM(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMD(c, k, src)
+}
+
+func execMToT(c *CSIRAC, k Word) error {
+	src := srcM(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstT(c, k, src)
+}
+
+func execIToM(c *CSIRAC, k Word) error {
+	src := srcI(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstM(c, k, src)
+}
+
+func execIToQ(c *CSIRAC, k Word) error {
+	src := srcI(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstQ(c, k, src)
+}
+
+func execIToOT(c *CSIRAC, k Word) error {
+	src := srcI(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOT(c, k, src)
+}
+
+func execIToOP(c *CSIRAC, k Word) error {
+	src := srcI(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOP(c, k, src)
+}
+
+func execIToA(c *CSIRAC, k Word) error {
+	src := srcI(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstA(c, k, src)
+}
+
+func execIToPA(c *CSIRAC, k Word) error {
+	src := srcI(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPA(c, k, src)
+}
+
+func execIToSA(c *CSIRAC, k Word) error {
+	src := srcI(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSA(c, k, src)
+}
+
+func execIToCA(c *CSIRAC, k Word) error {
+	src := srcI(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCA(c, k, src)
+}
+
+func execIToDA(c *CSIRAC, k Word) error {
+	src := srcI(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstDA(c, k, src)
+}
+
+func execIToNA(c *CSIRAC, k Word) error {
+	src := srcI(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstNA(c, k, src)
+}
+
+func execIToP(c *CSIRAC, k Word) error {
+	src := srcI(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstP(c, k, src)
+}
+
+func execIToB(c *CSIRAC, k Word) error {
+	src := srcI(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstB(c, k, src)
+}
+
+func execIToXB(c *CSIRAC, k Word) error {
+	src := srcI(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstXB(c, k, src)
+}
+
+func execIToL(c *CSIRAC, k Word) error {
+	src := srcI(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstL(c, k, src)
+}
+
+func execIToC(c *CSIRAC, k Word) error {
+	src := srcI(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstC(c, k, src)
+}
+
+func execIToPC(c *CSIRAC, k Word) error {
+	src := srcI(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPC(c, k, src)
+}
+
+func execIToSC(c *CSIRAC, k Word) error {
+	src := srcI(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSC(c, k, src)
+}
+
+func execIToD(c *CSIRAC, k Word) error {
+	src := srcI(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstD(c, k, src)
+}
+
+func execIToPD(c *CSIRAC, k Word) error {
+	src := srcI(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPD(c, k, src)
+}
+
+func execIToSD(c *CSIRAC, k Word) error {
+	src := srcI(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSD(c, k, src)
+}
+
+func execIToZ(c *CSIRAC, k Word) error {
+	src := srcI(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstZ(c, k, src)
+}
+
+func execIToHL(c *CSIRAC, k Word) error {
+	src := srcI(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHL(c, k, src)
+}
+
+func execIToHU(c *CSIRAC, k Word) error {
+	src := srcI(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHU(c, k, src)
+}
+
+func execIToS(c *CSIRAC, k Word) error {
+	src := srcI(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstS(c, k, src)
+}
+
+func execIToPS(c *CSIRAC, k Word) error {
+	src := srcI(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPS(c, k, src)
+}
+
+func execIToCS(c *CSIRAC, k Word) error {
+	src := srcI(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCS(c, k, src)
+}
+
+func execIToPK(c *CSIRAC, k Word) error {
+	src := srcI(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPK(c, k, src)
+}
+
+func execIToMA(c *CSIRAC, k Word) error {
+	src := srcI(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMA(c, k, src)
+}
+
+func execIToMB(c *CSIRAC, k Word) error {
+	src := srcI(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMB(c, k, src)
+}
+
+func execIToMC(c *CSIRAC, k Word) error {
+	src := srcI(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMC(c, k, src)
+}
+
+func execIToMD(c *CSIRAC, k Word) error {
+	src := srcI(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMD(c, k, src)
+}
+
+func execIToT(c *CSIRAC, k Word) error {
+	src := srcI(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstT(c, k, src)
+}
+
+func execNAToM(c *CSIRAC, k Word) error {
+	src := srcNA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstM(c, k, src)
+}
+
+func execNAToQ(c *CSIRAC, k Word) error {
+	src := srcNA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstQ(c, k, src)
+}
+
+func execNAToOT(c *CSIRAC, k Word) error {
+	src := srcNA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOT(c, k, src)
+}
+
+func execNAToOP(c *CSIRAC, k Word) error {
+	src := srcNA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOP(c, k, src)
+}
+
+func execNAToA(c *CSIRAC, k Word) error {
+	src := srcNA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstA(c, k, src)
+}
+
+func execNAToPA(c *CSIRAC, k Word) error {
+	src := srcNA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPA(c, k, src)
+}
+
+func execNAToSA(c *CSIRAC, k Word) error {
+	src := srcNA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSA(c, k, src)
+}
+
+func execNAToCA(c *CSIRAC, k Word) error {
+	src := srcNA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCA(c, k, src)
+}
+
+func execNAToDA(c *CSIRAC, k Word) error {
+	src := srcNA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstDA(c, k, src)
+}
+
+func execNAToNA(c *CSIRAC, k Word) error {
+	src := srcNA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstNA(c, k, src)
+}
+
+func execNAToP(c *CSIRAC, k Word) error {
+	src := srcNA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstP(c, k, src)
+}
+
+func execNAToB(c *CSIRAC, k Word) error {
+	src := srcNA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstB(c, k, src)
+}
+
+func execNAToXB(c *CSIRAC, k Word) error {
+	src := srcNA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstXB(c, k, src)
+}
+
+func execNAToL(c *CSIRAC, k Word) error {
+	src := srcNA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstL(c, k, src)
+}
+
+func execNAToC(c *CSIRAC, k Word) error {
+	src := srcNA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstC(c, k, src)
+}
+
+func execNAToPC(c *CSIRAC, k Word) error {
+	src := srcNA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPC(c, k, src)
+}
+
+func execNAToSC(c *CSIRAC, k Word) error {
+	src := srcNA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSC(c, k, src)
+}
+
+func execNAToD(c *CSIRAC, k Word) error {
+	src := srcNA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstD(c, k, src)
+}
+
+func execNAToPD(c *CSIRAC, k Word) error {
+	src := srcNA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPD(c, k, src)
+}
+
+func execNAToSD(c *CSIRAC, k Word) error {
+	src := srcNA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSD(c, k, src)
+}
+
+func execNAToZ(c *CSIRAC, k Word) error {
+	src := srcNA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstZ(c, k, src)
+}
+
+func execNAToHL(c *CSIRAC, k Word) error {
+	src := srcNA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHL(c, k, src)
+}
+
+func execNAToHU(c *CSIRAC, k Word) error {
+	src := srcNA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHU(c, k, src)
+}
+
+func execNAToS(c *CSIRAC, k Word) error {
+	src := srcNA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstS(c, k, src)
+}
+
+func execNAToPS(c *CSIRAC, k Word) error {
+	src := srcNA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPS(c, k, src)
+}
+
+func execNAToCS(c *CSIRAC, k Word) error {
+	src := srcNA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCS(c, k, src)
+}
+
+func execNAToPK(c *CSIRAC, k Word) error {
+	src := srcNA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPK(c, k, src)
+}
+
+func execNAToMA(c *CSIRAC, k Word) error {
+	src := srcNA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMA(c, k, src)
+}
+
+func execNAToMB(c *CSIRAC, k Word) error {
+	src := srcNA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMB(c, k, src)
+}
+
+func execNAToMC(c *CSIRAC, k Word) error {
+	src := srcNA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMC(c, k, src)
+}
+
+func execNAToMD(c *CSIRAC, k Word) error {
+	src := srcNA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMD(c, k, src)
+}
+
+func execNAToT(c *CSIRAC, k Word) error {
+	src := srcNA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstT(c, k, src)
+}
+
+func execNBToM(c *CSIRAC, k Word) error {
+	src := srcNB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstM(c, k, src)
+}
+
+func execNBToQ(c *CSIRAC, k Word) error {
+	src := srcNB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstQ(c, k, src)
+}
+
+func execNBToOT(c *CSIRAC, k Word) error {
+	src := srcNB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOT(c, k, src)
+}
+
+func execNBToOP(c *CSIRAC, k Word) error {
+	src := srcNB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOP(c, k, src)
+}
+
+func execNBToA(c *CSIRAC, k Word) error {
+	src := srcNB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstA(c, k, src)
+}
+
+func execNBToPA(c *CSIRAC, k Word) error {
+	src := srcNB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPA(c, k, src)
+}
+
+func execNBToSA(c *CSIRAC, k Word) error {
+	src := srcNB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSA(c, k, src)
+}
+
+func execNBToCA(c *CSIRAC, k Word) error {
+	src := srcNB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCA(c, k, src)
+}
+
+func execNBToDA(c *CSIRAC, k Word) error {
+	src := srcNB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstDA(c, k, src)
+}
+
+func execNBToNA(c *CSIRAC, k Word) error {
+	src := srcNB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstNA(c, k, src)
+}
+
+func execNBToP(c *CSIRAC, k Word) error {
+	src := srcNB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstP(c, k, src)
+}
+
+func execNBToB(c *CSIRAC, k Word) error {
+	src := srcNB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstB(c, k, src)
+}
+
+func execNBToXB(c *CSIRAC, k Word) error {
+	src := srcNB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstXB(c, k, src)
+}
+
+func execNBToL(c *CSIRAC, k Word) error {
+	src := srcNB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstL(c, k, src)
+}
+
+func execNBToC(c *CSIRAC, k Word) error {
+	src := srcNB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstC(c, k, src)
+}
+
+func execNBToPC(c *CSIRAC, k Word) error {
+	src := srcNB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPC(c, k, src)
+}
+
+func execNBToSC(c *CSIRAC, k Word) error {
+	src := srcNB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSC(c, k, src)
+}
+
+func execNBToD(c *CSIRAC, k Word) error {
+	src := srcNB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstD(c, k, src)
+}
+
+func execNBToPD(c *CSIRAC, k Word) error {
+	src := srcNB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPD(c, k, src)
+}
+
+func execNBToSD(c *CSIRAC, k Word) error {
+	src := srcNB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSD(c, k, src)
+}
+
+func execNBToZ(c *CSIRAC, k Word) error {
+	src := srcNB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstZ(c, k, src)
+}
+
+func execNBToHL(c *CSIRAC, k Word) error {
+	src := srcNB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHL(c, k, src)
+}
+
+func execNBToHU(c *CSIRAC, k Word) error {
+	src := srcNB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHU(c, k, src)
+}
+
+func execNBToS(c *CSIRAC, k Word) error {
+	src := srcNB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstS(c, k, src)
+}
+
+func execNBToPS(c *CSIRAC, k Word) error {
+	src := srcNB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPS(c, k, src)
+}
+
+func execNBToCS(c *CSIRAC, k Word) error {
+	src := srcNB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCS(c, k, src)
+}
+
+func execNBToPK(c *CSIRAC, k Word) error {
+	src := srcNB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPK(c, k, src)
+}
+
+func execNBToMA(c *CSIRAC, k Word) error {
+	src := srcNB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMA(c, k, src)
+}
+
+func execNBToMB(c *CSIRAC, k Word) error {
+	src := srcNB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMB(c, k, src)
+}
+
+func execNBToMC(c *CSIRAC, k Word) error {
+	src := srcNB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMC(c, k, src)
+}
+
+func execNBToMD(c *CSIRAC, k Word) error {
+	src := srcNB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMD(c, k, src)
+}
+
+func execNBToT(c *CSIRAC, k Word) error {
+	src := srcNB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstT(c, k, src)
+}
+
+func execAToM(c *CSIRAC, k Word) error {
+	src := srcA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstM(c, k, src)
+}
+
+func execAToQ(c *CSIRAC, k Word) error {
+	src := srcA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstQ(c, k, src)
+}
+
+func execAToOT(c *CSIRAC, k Word) error {
+	src := srcA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOT(c, k, src)
+}
+
+func execAToOP(c *CSIRAC, k Word) error {
+	src := srcA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOP(c, k, src)
+}
+
+func execAToA(c *CSIRAC, k Word) error {
+	src := srcA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstA(c, k, src)
+}
+
+func execAToPA(c *CSIRAC, k Word) error {
+	src := srcA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPA(c, k, src)
+}
+
+func execAToSA(c *CSIRAC, k Word) error {
+	src := srcA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSA(c, k, src)
+}
+
+func execAToCA(c *CSIRAC, k Word) error {
+	src := srcA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCA(c, k, src)
+}
+
+func execAToDA(c *CSIRAC, k Word) error {
+	src := srcA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstDA(c, k, src)
+}
+
+func execAToNA(c *CSIRAC, k Word) error {
+	src := srcA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstNA(c, k, src)
+}
+
+func execAToP(c *CSIRAC, k Word) error {
+	src := srcA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstP(c, k, src)
+}
+
+func execAToB(c *CSIRAC, k Word) error {
+	src := srcA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstB(c, k, src)
+}
+
+func execAToXB(c *CSIRAC, k Word) error {
+	src := srcA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstXB(c, k, src)
+}
+
+func execAToL(c *CSIRAC, k Word) error {
+	src := srcA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstL(c, k, src)
+}
+
+func execAToC(c *CSIRAC, k Word) error {
+	src := srcA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstC(c, k, src)
+}
+
+func execAToPC(c *CSIRAC, k Word) error {
+	src := srcA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPC(c, k, src)
+}
+
+func execAToSC(c *CSIRAC, k Word) error {
+	src := srcA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSC(c, k, src)
+}
+
+func execAToD(c *CSIRAC, k Word) error {
+	src := srcA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstD(c, k, src)
+}
+
+func execAToPD(c *CSIRAC, k Word) error {
+	src := srcA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPD(c, k, src)
+}
+
+func execAToSD(c *CSIRAC, k Word) error {
+	src := srcA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSD(c, k, src)
+}
+
+func execAToZ(c *CSIRAC, k Word) error {
+	src := srcA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstZ(c, k, src)
+}
+
+func execAToHL(c *CSIRAC, k Word) error {
+	src := srcA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHL(c, k, src)
+}
+
+func execAToHU(c *CSIRAC, k Word) error {
+	src := srcA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHU(c, k, src)
+}
+
+func execAToS(c *CSIRAC, k Word) error {
+	src := srcA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstS(c, k, src)
+}
+
+func execAToPS(c *CSIRAC, k Word) error {
+	src := srcA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPS(c, k, src)
+}
+
+func execAToCS(c *CSIRAC, k Word) error {
+	src := srcA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCS(c, k, src)
+}
+
+func execAToPK(c *CSIRAC, k Word) error {
+	src := srcA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPK(c, k, src)
+}
+
+func execAToMA(c *CSIRAC, k Word) error {
+	src := srcA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMA(c, k, src)
+}
+
+func execAToMB(c *CSIRAC, k Word) error {
+	src := srcA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMB(c, k, src)
+}
+
+func execAToMC(c *CSIRAC, k Word) error {
+	src := srcA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMC(c, k, src)
+}
+
+func execAToMD(c *CSIRAC, k Word) error {
+	src := srcA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMD(c, k, src)
+}
+
+func execAToT(c *CSIRAC, k Word) error {
+	src := srcA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstT(c, k, src)
+}
+
+func execSAToM(c *CSIRAC, k Word) error {
+	src := srcSA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstM(c, k, src)
+}
+
+func execSAToQ(c *CSIRAC, k Word) error {
+	src := srcSA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstQ(c, k, src)
+}
+
+func execSAToOT(c *CSIRAC, k Word) error {
+	src := srcSA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOT(c, k, src)
+}
+
+func execSAToOP(c *CSIRAC, k Word) error {
+	src := srcSA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOP(c, k, src)
+}
+
+func execSAToA(c *CSIRAC, k Word) error {
+	src := srcSA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstA(c, k, src)
+}
+
+func execSAToPA(c *CSIRAC, k Word) error {
+	src := srcSA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPA(c, k, src)
+}
+
+func execSAToSA(c *CSIRAC, k Word) error {
+	src := srcSA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSA(c, k, src)
+}
+
+func execSAToCA(c *CSIRAC, k Word) error {
+	src := srcSA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCA(c, k, src)
+}
+
+func execSAToDA(c *CSIRAC, k Word) error {
+	src := srcSA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstDA(c, k, src)
+}
+
+func execSAToNA(c *CSIRAC, k Word) error {
+	src := srcSA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstNA(c, k, src)
+}
+
+func execSAToP(c *CSIRAC, k Word) error {
+	src := srcSA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstP(c, k, src)
+}
+
+func execSAToB(c *CSIRAC, k Word) error {
+	src := srcSA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstB(c, k, src)
+}
+
+func execSAToXB(c *CSIRAC, k Word) error {
+	src := srcSA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstXB(c, k, src)
+}
+
+func execSAToL(c *CSIRAC, k Word) error {
+	src := srcSA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstL(c, k, src)
+}
+
+func execSAToC(c *CSIRAC, k Word) error {
+	src := srcSA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstC(c, k, src)
+}
+
+func execSAToPC(c *CSIRAC, k Word) error {
+	src := srcSA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPC(c, k, src)
+}
+
+func execSAToSC(c *CSIRAC, k Word) error {
+	src := srcSA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSC(c, k, src)
+}
+
+func execSAToD(c *CSIRAC, k Word) error {
+	src := srcSA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstD(c, k, src)
+}
+
+func execSAToPD(c *CSIRAC, k Word) error {
+	src := srcSA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPD(c, k, src)
+}
+
+func execSAToSD(c *CSIRAC, k Word) error {
+	src := srcSA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSD(c, k, src)
+}
+
+func execSAToZ(c *CSIRAC, k Word) error {
+	src := srcSA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstZ(c, k, src)
+}
+
+func execSAToHL(c *CSIRAC, k Word) error {
+	src := srcSA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHL(c, k, src)
+}
+
+func execSAToHU(c *CSIRAC, k Word) error {
+	src := srcSA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHU(c, k, src)
+}
+
+func execSAToS(c *CSIRAC, k Word) error {
+	src := srcSA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstS(c, k, src)
+}
+
+func execSAToPS(c *CSIRAC, k Word) error {
+	src := srcSA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPS(c, k, src)
+}
+
+func execSAToCS(c *CSIRAC, k Word) error {
+	src := srcSA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCS(c, k, src)
+}
+
+func execSAToPK(c *CSIRAC, k Word) error {
+	src := srcSA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPK(c, k, src)
+}
+
+func execSAToMA(c *CSIRAC, k Word) error {
+	src := srcSA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMA(c, k, src)
+}
+
+func execSAToMB(c *CSIRAC, k Word) error {
+	src := srcSA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMB(c, k, src)
+}
+
+func execSAToMC(c *CSIRAC, k Word) error {
+	src := srcSA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMC(c, k, src)
+}
+
+func execSAToMD(c *CSIRAC, k Word) error {
+	src := srcSA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMD(c, k, src)
+}
+
+func execSAToT(c *CSIRAC, k Word) error {
+	src := srcSA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstT(c, k, src)
+}
+
+func execHAToM(c *CSIRAC, k Word) error {
+	src := srcHA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstM(c, k, src)
+}
+
+func execHAToQ(c *CSIRAC, k Word) error {
+	src := srcHA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstQ(c, k, src)
+}
+
+func execHAToOT(c *CSIRAC, k Word) error {
+	src := srcHA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOT(c, k, src)
+}
+
+func execHAToOP(c *CSIRAC, k Word) error {
+	src := srcHA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOP(c, k, src)
+}
+
+func execHAToA(c *CSIRAC, k Word) error {
+	src := srcHA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstA(c, k, src)
+}
+
+func execHAToPA(c *CSIRAC, k Word) error {
+	src := srcHA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPA(c, k, src)
+}
+
+func execHAToSA(c *CSIRAC, k Word) error {
+	src := srcHA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSA(c, k, src)
+}
+
+func execHAToCA(c *CSIRAC, k Word) error {
+	src := srcHA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCA(c, k, src)
+}
+
+func execHAToDA(c *CSIRAC, k Word) error {
+	src := srcHA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstDA(c, k, src)
+}
+
+func execHAToNA(c *CSIRAC, k Word) error {
+	src := srcHA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstNA(c, k, src)
+}
+
+func execHAToP(c *CSIRAC, k Word) error {
+	src := srcHA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstP(c, k, src)
+}
+
+func execHAToB(c *CSIRAC, k Word) error {
+	src := srcHA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstB(c, k, src)
+}
+
+func execHAToXB(c *CSIRAC, k Word) error {
+	src := srcHA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstXB(c, k, src)
+}
+
+func execHAToL(c *CSIRAC, k Word) error {
+	src := srcHA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstL(c, k, src)
+}
+
+func execHAToC(c *CSIRAC, k Word) error {
+	src := srcHA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstC(c, k, src)
+}
+
+func execHAToPC(c *CSIRAC, k Word) error {
+	src := srcHA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPC(c, k, src)
+}
+
+func execHAToSC(c *CSIRAC, k Word) error {
+	src := srcHA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSC(c, k, src)
+}
+
+func execHAToD(c *CSIRAC, k Word) error {
+	src := srcHA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstD(c, k, src)
+}
+
+func execHAToPD(c *CSIRAC, k Word) error {
+	src := srcHA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPD(c, k, src)
+}
+
+func execHAToSD(c *CSIRAC, k Word) error {
+	src := srcHA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSD(c, k, src)
+}
+
+func execHAToZ(c *CSIRAC, k Word) error {
+	src := srcHA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstZ(c, k, src)
+}
+
+func execHAToHL(c *CSIRAC, k Word) error {
+	src := srcHA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHL(c, k, src)
+}
+
+func execHAToHU(c *CSIRAC, k Word) error {
+	src := srcHA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHU(c, k, src)
+}
+
+func execHAToS(c *CSIRAC, k Word) error {
+	src := srcHA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstS(c, k, src)
+}
+
+func execHAToPS(c *CSIRAC, k Word) error {
+	src := srcHA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPS(c, k, src)
+}
+
+func execHAToCS(c *CSIRAC, k Word) error {
+	src := srcHA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCS(c, k, src)
+}
+
+func execHAToPK(c *CSIRAC, k Word) error {
+	src := srcHA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPK(c, k, src)
+}
+
+func execHAToMA(c *CSIRAC, k Word) error {
+	src := srcHA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMA(c, k, src)
+}
+
+func execHAToMB(c *CSIRAC, k Word) error {
+	src := srcHA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMB(c, k, src)
+}
+
+func execHAToMC(c *CSIRAC, k Word) error {
+	src := srcHA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMC(c, k, src)
+}
+
+func execHAToMD(c *CSIRAC, k Word) error {
+	src := srcHA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMD(c, k, src)
+}
+
+func execHAToT(c *CSIRAC, k Word) error {
+	src := srcHA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstT(c, k, src)
+}
+
+func execTAToM(c *CSIRAC, k Word) error {
+	src := srcTA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstM(c, k, src)
+}
+
+func execTAToQ(c *CSIRAC, k Word) error {
+	src := srcTA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstQ(c, k, src)
+}
+
+func execTAToOT(c *CSIRAC, k Word) error {
+	src := srcTA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOT(c, k, src)
+}
+
+func execTAToOP(c *CSIRAC, k Word) error {
+	src := srcTA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOP(c, k, src)
+}
+
+func execTAToA(c *CSIRAC, k Word) error {
+	src := srcTA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstA(c, k, src)
+}
+
+func execTAToPA(c *CSIRAC, k Word) error {
+	src := srcTA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPA(c, k, src)
+}
+
+func execTAToSA(c *CSIRAC, k Word) error {
+	src := srcTA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSA(c, k, src)
+}
+
+func execTAToCA(c *CSIRAC, k Word) error {
+	src := srcTA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCA(c, k, src)
+}
+
+func execTAToDA(c *CSIRAC, k Word) error {
+	src := srcTA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstDA(c, k, src)
+}
+
+func execTAToNA(c *CSIRAC, k Word) error {
+	src := srcTA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstNA(c, k, src)
+}
+
+func execTAToP(c *CSIRAC, k Word) error {
+	src := srcTA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstP(c, k, src)
+}
+
+func execTAToB(c *CSIRAC, k Word) error {
+	src := srcTA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstB(c, k, src)
+}
+
+func execTAToXB(c *CSIRAC, k Word) error {
+	src := srcTA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstXB(c, k, src)
+}
+
+func execTAToL(c *CSIRAC, k Word) error {
+	src := srcTA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstL(c, k, src)
+}
+
+func execTAToC(c *CSIRAC, k Word) error {
+	src := srcTA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstC(c, k, src)
+}
+
+func execTAToPC(c *CSIRAC, k Word) error {
+	src := srcTA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPC(c, k, src)
+}
+
+func execTAToSC(c *CSIRAC, k Word) error {
+	src := srcTA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSC(c, k, src)
+}
+
+func execTAToD(c *CSIRAC, k Word) error {
+	src := srcTA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstD(c, k, src)
+}
+
+func execTAToPD(c *CSIRAC, k Word) error {
+	src := srcTA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPD(c, k, src)
+}
+
+func execTAToSD(c *CSIRAC, k Word) error {
+	src := srcTA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSD(c, k, src)
+}
+
+func execTAToZ(c *CSIRAC, k Word) error {
+	src := srcTA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstZ(c, k, src)
+}
+
+func execTAToHL(c *CSIRAC, k Word) error {
+	src := srcTA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHL(c, k, src)
+}
+
+func execTAToHU(c *CSIRAC, k Word) error {
+	src := srcTA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHU(c, k, src)
+}
+
+func execTAToS(c *CSIRAC, k Word) error {
+	src := srcTA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstS(c, k, src)
+}
+
+func execTAToPS(c *CSIRAC, k Word) error {
+	src := srcTA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPS(c, k, src)
+}
+
+func execTAToCS(c *CSIRAC, k Word) error {
+	src := srcTA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCS(c, k, src)
+}
+
+func execTAToPK(c *CSIRAC, k Word) error {
+	src := srcTA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPK(c, k, src)
+}
+
+func execTAToMA(c *CSIRAC, k Word) error {
+	src := srcTA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMA(c, k, src)
+}
+
+func execTAToMB(c *CSIRAC, k Word) error {
+	src := srcTA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMB(c, k, src)
+}
+
+func execTAToMC(c *CSIRAC, k Word) error {
+	src := srcTA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMC(c, k, src)
+}
+
+func execTAToMD(c *CSIRAC, k Word) error {
+	src := srcTA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMD(c, k, src)
+}
+
+func execTAToT(c *CSIRAC, k Word) error {
+	src := srcTA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstT(c, k, src)
+}
+
+func execLAToM(c *CSIRAC, k Word) error {
+	src := srcLA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstM(c, k, src)
+}
+
+func execLAToQ(c *CSIRAC, k Word) error {
+	src := srcLA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstQ(c, k, src)
+}
+
+func execLAToOT(c *CSIRAC, k Word) error {
+	src := srcLA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOT(c, k, src)
+}
+
+func execLAToOP(c *CSIRAC, k Word) error {
+	src := srcLA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOP(c, k, src)
+}
+
+func execLAToA(c *CSIRAC, k Word) error {
+	src := srcLA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstA(c, k, src)
+}
+
+func execLAToPA(c *CSIRAC, k Word) error {
+	src := srcLA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPA(c, k, src)
+}
+
+func execLAToSA(c *CSIRAC, k Word) error {
+	src := srcLA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSA(c, k, src)
+}
+
+func execLAToCA(c *CSIRAC, k Word) error {
+	src := srcLA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCA(c, k, src)
+}
+
+func execLAToDA(c *CSIRAC, k Word) error {
+	src := srcLA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstDA(c, k, src)
+}
+
+func execLAToNA(c *CSIRAC, k Word) error {
+	src := srcLA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstNA(c, k, src)
+}
+
+func execLAToP(c *CSIRAC, k Word) error {
+	src := srcLA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstP(c, k, src)
+}
+
+func execLAToB(c *CSIRAC, k Word) error {
+	src := srcLA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstB(c, k, src)
+}
+
+func execLAToXB(c *CSIRAC, k Word) error {
+	src := srcLA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstXB(c, k, src)
+}
+
+func execLAToL(c *CSIRAC, k Word) error {
+	src := srcLA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstL(c, k, src)
+}
+
+func execLAToC(c *CSIRAC, k Word) error {
+	src := srcLA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstC(c, k, src)
+}
+
+func execLAToPC(c *CSIRAC, k Word) error {
+	src := srcLA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPC(c, k, src)
+}
+
+func execLAToSC(c *CSIRAC, k Word) error {
+	src := srcLA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSC(c, k, src)
+}
+
+func execLAToD(c *CSIRAC, k Word) error {
+	src := srcLA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstD(c, k, src)
+}
+
+func execLAToPD(c *CSIRAC, k Word) error {
+	src := srcLA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPD(c, k, src)
+}
+
+func execLAToSD(c *CSIRAC, k Word) error {
+	src := srcLA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSD(c, k, src)
+}
+
+func execLAToZ(c *CSIRAC, k Word) error {
+	src := srcLA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstZ(c, k, src)
+}
+
+func execLAToHL(c *CSIRAC, k Word) error {
+	src := srcLA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHL(c, k, src)
+}
+
+func execLAToHU(c *CSIRAC, k Word) error {
+	src := srcLA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHU(c, k, src)
+}
+
+func execLAToS(c *CSIRAC, k Word) error {
+	src := srcLA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstS(c, k, src)
+}
+
+func execLAToPS(c *CSIRAC, k Word) error {
+	src := srcLA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPS(c, k, src)
+}
+
+func execLAToCS(c *CSIRAC, k Word) error {
+	src := srcLA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCS(c, k, src)
+}
+
+func execLAToPK(c *CSIRAC, k Word) error {
+	src := srcLA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPK(c, k, src)
+}
+
+func execLAToMA(c *CSIRAC, k Word) error {
+	src := srcLA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMA(c, k, src)
+}
+
+func execLAToMB(c *CSIRAC, k Word) error {
+	src := srcLA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMB(c, k, src)
+}
+
+func execLAToMC(c *CSIRAC, k Word) error {
+	src := srcLA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMC(c, k, src)
+}
+
+func execLAToMD(c *CSIRAC, k Word) error {
+	src := srcLA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMD(c, k, src)
+}
+
+func execLAToT(c *CSIRAC, k Word) error {
+	src := srcLA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstT(c, k, src)
+}
+
+func execCAToM(c *CSIRAC, k Word) error {
+	src := srcCA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstM(c, k, src)
+}
+
+func execCAToQ(c *CSIRAC, k Word) error {
+	src := srcCA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstQ(c, k, src)
+}
+
+func execCAToOT(c *CSIRAC, k Word) error {
+	src := srcCA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOT(c, k, src)
+}
+
+func execCAToOP(c *CSIRAC, k Word) error {
+	src := srcCA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOP(c, k, src)
+}
+
+func execCAToA(c *CSIRAC, k Word) error {
+	src := srcCA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstA(c, k, src)
+}
+
+func execCAToPA(c *CSIRAC, k Word) error {
+	src := srcCA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPA(c, k, src)
+}
+
+func execCAToSA(c *CSIRAC, k Word) error {
+	src := srcCA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSA(c, k, src)
+}
+
+func execCAToCA(c *CSIRAC, k Word) error {
+	src := srcCA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCA(c, k, src)
+}
+
+func execCAToDA(c *CSIRAC, k Word) error {
+	src := srcCA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstDA(c, k, src)
+}
+
+func execCAToNA(c *CSIRAC, k Word) error {
+	src := srcCA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstNA(c, k, src)
+}
+
+func execCAToP(c *CSIRAC, k Word) error {
+	src := srcCA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstP(c, k, src)
+}
+
+func execCAToB(c *CSIRAC, k Word) error {
+	src := srcCA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstB(c, k, src)
+}
+
+func execCAToXB(c *CSIRAC, k Word) error {
+	src := srcCA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstXB(c, k, src)
+}
+
+func execCAToL(c *CSIRAC, k Word) error {
+	src := srcCA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstL(c, k, src)
+}
+
+func execCAToC(c *CSIRAC, k Word) error {
+	src := srcCA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstC(c, k, src)
+}
+
+func execCAToPC(c *CSIRAC, k Word) error {
+	src := srcCA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPC(c, k, src)
+}
+
+func execCAToSC(c *CSIRAC, k Word) error {
+	src := srcCA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSC(c, k, src)
+}
+
+func execCAToD(c *CSIRAC, k Word) error {
+	src := srcCA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstD(c, k, src)
+}
+
+func execCAToPD(c *CSIRAC, k Word) error {
+	src := srcCA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPD(c, k, src)
+}
+
+func execCAToSD(c *CSIRAC, k Word) error {
+	src := srcCA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSD(c, k, src)
+}
+
+func execCAToZ(c *CSIRAC, k Word) error {
+	src := srcCA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstZ(c, k, src)
+}
+
+func execCAToHL(c *CSIRAC, k Word) error {
+	src := srcCA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHL(c, k, src)
+}
+
+func execCAToHU(c *CSIRAC, k Word) error {
+	src := srcCA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHU(c, k, src)
+}
+
+func execCAToS(c *CSIRAC, k Word) error {
+	src := srcCA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstS(c, k, src)
+}
+
+func execCAToPS(c *CSIRAC, k Word) error {
+	src := srcCA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPS(c, k, src)
+}
+
+func execCAToCS(c *CSIRAC, k Word) error {
+	src := srcCA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCS(c, k, src)
+}
+
+func execCAToPK(c *CSIRAC, k Word) error {
+	src := srcCA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPK(c, k, src)
+}
+
+func execCAToMA(c *CSIRAC, k Word) error {
+	src := srcCA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMA(c, k, src)
+}
+
+func execCAToMB(c *CSIRAC, k Word) error {
+	src := srcCA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMB(c, k, src)
+}
+
+func execCAToMC(c *CSIRAC, k Word) error {
+	src := srcCA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMC(c, k, src)
+}
+
+func execCAToMD(c *CSIRAC, k Word) error {
+	src := srcCA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMD(c, k, src)
+}
+
+func execCAToT(c *CSIRAC, k Word) error {
+	src := srcCA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstT(c, k, src)
+}
+
+func execZAToM(c *CSIRAC, k Word) error {
+	src := srcZA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstM(c, k, src)
+}
+
+func execZAToQ(c *CSIRAC, k Word) error {
+	src := srcZA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstQ(c, k, src)
+}
+
+func execZAToOT(c *CSIRAC, k Word) error {
+	src := srcZA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOT(c, k, src)
+}
+
+func execZAToOP(c *CSIRAC, k Word) error {
+	src := srcZA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOP(c, k, src)
+}
+
+func execZAToA(c *CSIRAC, k Word) error {
+	src := srcZA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstA(c, k, src)
+}
+
+func execZAToPA(c *CSIRAC, k Word) error {
+	src := srcZA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPA(c, k, src)
+}
+
+func execZAToSA(c *CSIRAC, k Word) error {
+	src := srcZA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSA(c, k, src)
+}
+
+func execZAToCA(c *CSIRAC, k Word) error {
+	src := srcZA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCA(c, k, src)
+}
+
+func execZAToDA(c *CSIRAC, k Word) error {
+	src := srcZA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstDA(c, k, src)
+}
+
+func execZAToNA(c *CSIRAC, k Word) error {
+	src := srcZA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstNA(c, k, src)
+}
+
+func execZAToP(c *CSIRAC, k Word) error {
+	src := srcZA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstP(c, k, src)
+}
+
+func execZAToB(c *CSIRAC, k Word) error {
+	src := srcZA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstB(c, k, src)
+}
+
+func execZAToXB(c *CSIRAC, k Word) error {
+	src := srcZA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstXB(c, k, src)
+}
+
+func execZAToL(c *CSIRAC, k Word) error {
+	src := srcZA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstL(c, k, src)
+}
+
+func execZAToC(c *CSIRAC, k Word) error {
+	src := srcZA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstC(c, k, src)
+}
+
+func execZAToPC(c *CSIRAC, k Word) error {
+	src := srcZA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPC(c, k, src)
+}
+
+func execZAToSC(c *CSIRAC, k Word) error {
+	src := srcZA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSC(c, k, src)
+}
+
+func execZAToD(c *CSIRAC, k Word) error {
+	src := srcZA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstD(c, k, src)
+}
+
+func execZAToPD(c *CSIRAC, k Word) error {
+	src := srcZA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPD(c, k, src)
+}
+
+func execZAToSD(c *CSIRAC, k Word) error {
+	src := srcZA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSD(c, k, src)
+}
+
+func execZAToZ(c *CSIRAC, k Word) error {
+	src := srcZA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstZ(c, k, src)
+}
+
+func execZAToHL(c *CSIRAC, k Word) error {
+	src := srcZA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHL(c, k, src)
+}
+
+func execZAToHU(c *CSIRAC, k Word) error {
+	src := srcZA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHU(c, k, src)
+}
+
+func execZAToS(c *CSIRAC, k Word) error {
+	src := srcZA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstS(c, k, src)
+}
+
+func execZAToPS(c *CSIRAC, k Word) error {
+	src := srcZA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPS(c, k, src)
+}
+
+func execZAToCS(c *CSIRAC, k Word) error {
+	src := srcZA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCS(c, k, src)
+}
+
+func execZAToPK(c *CSIRAC, k Word) error {
+	src := srcZA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPK(c, k, src)
+}
+
+func execZAToMA(c *CSIRAC, k Word) error {
+	src := srcZA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMA(c, k, src)
+}
+
+func execZAToMB(c *CSIRAC, k Word) error {
+	src := srcZA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMB(c, k, src)
+}
+
+func execZAToMC(c *CSIRAC, k Word) error {
+	src := srcZA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMC(c, k, src)
+}
+
+func execZAToMD(c *CSIRAC, k Word) error {
+	src := srcZA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMD(c, k, src)
+}
+
+func execZAToT(c *CSIRAC, k Word) error {
+	src := srcZA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstT(c, k, src)
+}
+
+func execBToM(c *CSIRAC, k Word) error {
+	src := srcB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstM(c, k, src)
+}
+
+func execBToQ(c *CSIRAC, k Word) error {
+	src := srcB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstQ(c, k, src)
+}
+
+func execBToOT(c *CSIRAC, k Word) error {
+	src := srcB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOT(c, k, src)
+}
+
+func execBToOP(c *CSIRAC, k Word) error {
+	src := srcB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOP(c, k, src)
+}
+
+func execBToA(c *CSIRAC, k Word) error {
+	src := srcB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstA(c, k, src)
+}
+
+func execBToPA(c *CSIRAC, k Word) error {
+	src := srcB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPA(c, k, src)
+}
+
+func execBToSA(c *CSIRAC, k Word) error {
+	src := srcB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSA(c, k, src)
+}
+
+func execBToCA(c *CSIRAC, k Word) error {
+	src := srcB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCA(c, k, src)
+}
+
+func execBToDA(c *CSIRAC, k Word) error {
+	src := srcB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstDA(c, k, src)
+}
+
+func execBToNA(c *CSIRAC, k Word) error {
+	src := srcB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstNA(c, k, src)
+}
+
+func execBToP(c *CSIRAC, k Word) error {
+	src := srcB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstP(c, k, src)
+}
+
+func execBToB(c *CSIRAC, k Word) error {
+	src := srcB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstB(c, k, src)
+}
+
+func execBToXB(c *CSIRAC, k Word) error {
+	src := srcB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstXB(c, k, src)
+}
+
+func execBToL(c *CSIRAC, k Word) error {
+	src := srcB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstL(c, k, src)
+}
+
+func execBToC(c *CSIRAC, k Word) error {
+	src := srcB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstC(c, k, src)
+}
+
+func execBToPC(c *CSIRAC, k Word) error {
+	src := srcB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPC(c, k, src)
+}
+
+func execBToSC(c *CSIRAC, k Word) error {
+	src := srcB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSC(c, k, src)
+}
+
+func execBToD(c *CSIRAC, k Word) error {
+	src := srcB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstD(c, k, src)
+}
+
+func execBToPD(c *CSIRAC, k Word) error {
+	src := srcB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPD(c, k, src)
+}
+
+func execBToSD(c *CSIRAC, k Word) error {
+	src := srcB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSD(c, k, src)
+}
+
+func execBToZ(c *CSIRAC, k Word) error {
+	src := srcB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstZ(c, k, src)
+}
+
+func execBToHL(c *CSIRAC, k Word) error {
+	src := srcB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHL(c, k, src)
+}
+
+func execBToHU(c *CSIRAC, k Word) error {
+	src := srcB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHU(c, k, src)
+}
+
+func execBToS(c *CSIRAC, k Word) error {
+	src := srcB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstS(c, k, src)
+}
+
+func execBToPS(c *CSIRAC, k Word) error {
+	src := srcB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPS(c, k, src)
+}
+
+func execBToCS(c *CSIRAC, k Word) error {
+	src := srcB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCS(c, k, src)
+}
+
+func execBToPK(c *CSIRAC, k Word) error {
+	src := srcB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPK(c, k, src)
+}
+
+func execBToMA(c *CSIRAC, k Word) error {
+	src := srcB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMA(c, k, src)
+}
+
+func execBToMB(c *CSIRAC, k Word) error {
+	src := srcB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMB(c, k, src)
+}
+
+func execBToMC(c *CSIRAC, k Word) error {
+	src := srcB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMC(c, k, src)
+}
+
+func execBToMD(c *CSIRAC, k Word) error {
+	src := srcB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMD(c, k, src)
+}
+
+func execBToT(c *CSIRAC, k Word) error {
+	src := srcB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstT(c, k, src)
+}
+
+func execRToM(c *CSIRAC, k Word) error {
+	src := srcR(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstM(c, k, src)
+}
+
+func execRToQ(c *CSIRAC, k Word) error {
+	src := srcR(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstQ(c, k, src)
+}
+
+func execRToOT(c *CSIRAC, k Word) error {
+	src := srcR(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOT(c, k, src)
+}
+
+func execRToOP(c *CSIRAC, k Word) error {
+	src := srcR(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOP(c, k, src)
+}
+
+func execRToA(c *CSIRAC, k Word) error {
+	src := srcR(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstA(c, k, src)
+}
+
+func execRToPA(c *CSIRAC, k Word) error {
+	src := srcR(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPA(c, k, src)
+}
+
+func execRToSA(c *CSIRAC, k Word) error {
+	src := srcR(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSA(c, k, src)
+}
+
+func execRToCA(c *CSIRAC, k Word) error {
+	src := srcR(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCA(c, k, src)
+}
+
+func execRToDA(c *CSIRAC, k Word) error {
+	src := srcR(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstDA(c, k, src)
+}
+
+func execRToNA(c *CSIRAC, k Word) error {
+	src := srcR(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstNA(c, k, src)
+}
+
+func execRToP(c *CSIRAC, k Word) error {
+	src := srcR(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstP(c, k, src)
+}
+
+func execRToB(c *CSIRAC, k Word) error {
+	src := srcR(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstB(c, k, src)
+}
+
+func execRToXB(c *CSIRAC, k Word) error {
+	src := srcR(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstXB(c, k, src)
+}
+
+func execRToL(c *CSIRAC, k Word) error {
+	src := srcR(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstL(c, k, src)
+}
+
+func execRToC(c *CSIRAC, k Word) error {
+	src := srcR(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstC(c, k, src)
+}
+
+func execRToPC(c *CSIRAC, k Word) error {
+	src := srcR(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPC(c, k, src)
+}
+
+func execRToSC(c *CSIRAC, k Word) error {
+	src := srcR(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSC(c, k, src)
+}
+
+func execRToD(c *CSIRAC, k Word) error {
+	src := srcR(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstD(c, k, src)
+}
+
+func execRToPD(c *CSIRAC, k Word) error {
+	src := srcR(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPD(c, k, src)
+}
+
+func execRToSD(c *CSIRAC, k Word) error {
+	src := srcR(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSD(c, k, src)
+}
+
+func execRToZ(c *CSIRAC, k Word) error {
+	src := srcR(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstZ(c, k, src)
+}
+
+func execRToHL(c *CSIRAC, k Word) error {
+	src := srcR(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHL(c, k, src)
+}
+
+func execRToHU(c *CSIRAC, k Word) error {
+	src := srcR(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHU(c, k, src)
+}
+
+func execRToS(c *CSIRAC, k Word) error {
+	src := srcR(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstS(c, k, src)
+}
+
+func execRToPS(c *CSIRAC, k Word) error {
+	src := srcR(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPS(c, k, src)
+}
+
+func execRToCS(c *CSIRAC, k Word) error {
+	src := srcR(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCS(c, k, src)
+}
+
+func execRToPK(c *CSIRAC, k Word) error {
+	src := srcR(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPK(c, k, src)
+}
+
+func execRToMA(c *CSIRAC, k Word) error {
+	src := srcR(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMA(c, k, src)
+}
+
+func execRToMB(c *CSIRAC, k Word) error {
+	src := srcR(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMB(c, k, src)
+}
+
+func execRToMC(c *CSIRAC, k Word) error {
+	src := srcR(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMC(c, k, src)
+}
+
+func execRToMD(c *CSIRAC, k Word) error {
+	src := srcR(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMD(c, k, src)
+}
+
+func execRToT(c *CSIRAC, k Word) error {
+	src := srcR(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstT(c, k, src)
+}
+
+func execRBToM(c *CSIRAC, k Word) error {
+	src := srcRB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstM(c, k, src)
+}
+
+func execRBToQ(c *CSIRAC, k Word) error {
+	src := srcRB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstQ(c, k, src)
+}
+
+func execRBToOT(c *CSIRAC, k Word) error {
+	src := srcRB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOT(c, k, src)
+}
+
+func execRBToOP(c *CSIRAC, k Word) error {
+	src := srcRB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOP(c, k, src)
+}
+
+func execRBToA(c *CSIRAC, k Word) error {
+	src := srcRB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstA(c, k, src)
+}
+
+func execRBToPA(c *CSIRAC, k Word) error {
+	src := srcRB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPA(c, k, src)
+}
+
+func execRBToSA(c *CSIRAC, k Word) error {
+	src := srcRB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSA(c, k, src)
+}
+
+func execRBToCA(c *CSIRAC, k Word) error {
+	src := srcRB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCA(c, k, src)
+}
+
+func execRBToDA(c *CSIRAC, k Word) error {
+	src := srcRB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstDA(c, k, src)
+}
+
+func execRBToNA(c *CSIRAC, k Word) error {
+	src := srcRB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstNA(c, k, src)
+}
+
+func execRBToP(c *CSIRAC, k Word) error {
+	src := srcRB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstP(c, k, src)
+}
+
+func execRBToB(c *CSIRAC, k Word) error {
+	src := srcRB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstB(c, k, src)
+}
+
+func execRBToXB(c *CSIRAC, k Word) error {
+	src := srcRB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstXB(c, k, src)
+}
+
+func execRBToL(c *CSIRAC, k Word) error {
+	src := srcRB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstL(c, k, src)
+}
+
+func execRBToC(c *CSIRAC, k Word) error {
+	src := srcRB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstC(c, k, src)
+}
+
+func execRBToPC(c *CSIRAC, k Word) error {
+	src := srcRB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPC(c, k, src)
+}
+
+func execRBToSC(c *CSIRAC, k Word) error {
+	src := srcRB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSC(c, k, src)
+}
+
+func execRBToD(c *CSIRAC, k Word) error {
+	src := srcRB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstD(c, k, src)
+}
+
+func execRBToPD(c *CSIRAC, k Word) error {
+	src := srcRB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPD(c, k, src)
+}
+
+func execRBToSD(c *CSIRAC, k Word) error {
+	src := srcRB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSD(c, k, src)
+}
+
+func execRBToZ(c *CSIRAC, k Word) error {
+	src := srcRB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstZ(c, k, src)
+}
+
+func execRBToHL(c *CSIRAC, k Word) error {
+	src := srcRB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHL(c, k, src)
+}
+
+func execRBToHU(c *CSIRAC, k Word) error {
+	src := srcRB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHU(c, k, src)
+}
+
+func execRBToS(c *CSIRAC, k Word) error {
+	src := srcRB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstS(c, k, src)
+}
+
+func execRBToPS(c *CSIRAC, k Word) error {
+	src := srcRB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPS(c, k, src)
+}
+
+func execRBToCS(c *CSIRAC, k Word) error {
+	src := srcRB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCS(c, k, src)
+}
+
+func execRBToPK(c *CSIRAC, k Word) error {
+	src := srcRB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPK(c, k, src)
+}
+
+func execRBToMA(c *CSIRAC, k Word) error {
+	src := srcRB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMA(c, k, src)
+}
+
+func execRBToMB(c *CSIRAC, k Word) error {
+	src := srcRB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMB(c, k, src)
+}
+
+func execRBToMC(c *CSIRAC, k Word) error {
+	src := srcRB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMC(c, k, src)
+}
+
+func execRBToMD(c *CSIRAC, k Word) error {
+	src := srcRB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMD(c, k, src)
+}
+
+func execRBToT(c *CSIRAC, k Word) error {
+	src := srcRB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstT(c, k, src)
+}
+
+func execCToM(c *CSIRAC, k Word) error {
+	src := srcC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstM(c, k, src)
+}
+
+func execCToQ(c *CSIRAC, k Word) error {
+	src := srcC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstQ(c, k, src)
+}
+
+func execCToOT(c *CSIRAC, k Word) error {
+	src := srcC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOT(c, k, src)
+}
+
+func execCToOP(c *CSIRAC, k Word) error {
+	src := srcC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOP(c, k, src)
+}
+
+func execCToA(c *CSIRAC, k Word) error {
+	src := srcC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstA(c, k, src)
+}
+
+func execCToPA(c *CSIRAC, k Word) error {
+	src := srcC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPA(c, k, src)
+}
+
+func execCToSA(c *CSIRAC, k Word) error {
+	src := srcC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSA(c, k, src)
+}
+
+func execCToCA(c *CSIRAC, k Word) error {
+	src := srcC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCA(c, k, src)
+}
+
+func execCToDA(c *CSIRAC, k Word) error {
+	src := srcC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstDA(c, k, src)
+}
+
+func execCToNA(c *CSIRAC, k Word) error {
+	src := srcC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstNA(c, k, src)
+}
+
+func execCToP(c *CSIRAC, k Word) error {
+	src := srcC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstP(c, k, src)
+}
+
+func execCToB(c *CSIRAC, k Word) error {
+	src := srcC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstB(c, k, src)
+}
+
+func execCToXB(c *CSIRAC, k Word) error {
+	src := srcC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstXB(c, k, src)
+}
+
+func execCToL(c *CSIRAC, k Word) error {
+	src := srcC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstL(c, k, src)
+}
+
+func execCToC(c *CSIRAC, k Word) error {
+	src := srcC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstC(c, k, src)
+}
+
+func execCToPC(c *CSIRAC, k Word) error {
+	src := srcC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPC(c, k, src)
+}
+
+func execCToSC(c *CSIRAC, k Word) error {
+	src := srcC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSC(c, k, src)
+}
+
+func execCToD(c *CSIRAC, k Word) error {
+	src := srcC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstD(c, k, src)
+}
+
+func execCToPD(c *CSIRAC, k Word) error {
+	src := srcC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPD(c, k, src)
+}
+
+func execCToSD(c *CSIRAC, k Word) error {
+	src := srcC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSD(c, k, src)
+}
+
+func execCToZ(c *CSIRAC, k Word) error {
+	src := srcC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstZ(c, k, src)
+}
+
+func execCToHL(c *CSIRAC, k Word) error {
+	src := srcC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHL(c, k, src)
+}
+
+func execCToHU(c *CSIRAC, k Word) error {
+	src := srcC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHU(c, k, src)
+}
+
+func execCToS(c *CSIRAC, k Word) error {
+	src := srcC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstS(c, k, src)
+}
+
+func execCToPS(c *CSIRAC, k Word) error {
+	src := srcC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPS(c, k, src)
+}
+
+func execCToCS(c *CSIRAC, k Word) error {
+	src := srcC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCS(c, k, src)
+}
+
+func execCToPK(c *CSIRAC, k Word) error {
+	src := srcC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPK(c, k, src)
+}
+
+func execCToMA(c *CSIRAC, k Word) error {
+	src := srcC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMA(c, k, src)
+}
+
+func execCToMB(c *CSIRAC, k Word) error {
+	src := srcC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMB(c, k, src)
+}
+
+func execCToMC(c *CSIRAC, k Word) error {
+	src := srcC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMC(c, k, src)
+}
+
+func execCToMD(c *CSIRAC, k Word) error {
+	src := srcC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMD(c, k, src)
+}
+
+func execCToT(c *CSIRAC, k Word) error {
+	src := srcC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstT(c, k, src)
+}
+
+func execSCToM(c *CSIRAC, k Word) error {
+	src := srcSC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstM(c, k, src)
+}
+
+func execSCToQ(c *CSIRAC, k Word) error {
+	src := srcSC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstQ(c, k, src)
+}
+
+func execSCToOT(c *CSIRAC, k Word) error {
+	src := srcSC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOT(c, k, src)
+}
+
+func execSCToOP(c *CSIRAC, k Word) error {
+	src := srcSC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOP(c, k, src)
+}
+
+func execSCToA(c *CSIRAC, k Word) error {
+	src := srcSC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstA(c, k, src)
+}
+
+func execSCToPA(c *CSIRAC, k Word) error {
+	src := srcSC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPA(c, k, src)
+}
+
+func execSCToSA(c *CSIRAC, k Word) error {
+	src := srcSC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSA(c, k, src)
+}
+
+func execSCToCA(c *CSIRAC, k Word) error {
+	src := srcSC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCA(c, k, src)
+}
+
+func execSCToDA(c *CSIRAC, k Word) error {
+	src := srcSC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstDA(c, k, src)
+}
+
+func execSCToNA(c *CSIRAC, k Word) error {
+	src := srcSC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstNA(c, k, src)
+}
+
+func execSCToP(c *CSIRAC, k Word) error {
+	src := srcSC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstP(c, k, src)
+}
+
+func execSCToB(c *CSIRAC, k Word) error {
+	src := srcSC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstB(c, k, src)
+}
+
+func execSCToXB(c *CSIRAC, k Word) error {
+	src := srcSC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstXB(c, k, src)
+}
+
+func execSCToL(c *CSIRAC, k Word) error {
+	src := srcSC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstL(c, k, src)
+}
+
+func execSCToC(c *CSIRAC, k Word) error {
+	src := srcSC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstC(c, k, src)
+}
+
+func execSCToPC(c *CSIRAC, k Word) error {
+	src := srcSC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPC(c, k, src)
+}
+
+func execSCToSC(c *CSIRAC, k Word) error {
+	src := srcSC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSC(c, k, src)
+}
+
+func execSCToD(c *CSIRAC, k Word) error {
+	src := srcSC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstD(c, k, src)
+}
+
+func execSCToPD(c *CSIRAC, k Word) error {
+	src := srcSC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPD(c, k, src)
+}
+
+func execSCToSD(c *CSIRAC, k Word) error {
+	src := srcSC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSD(c, k, src)
+}
+
+func execSCToZ(c *CSIRAC, k Word) error {
+	src := srcSC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstZ(c, k, src)
+}
+
+func execSCToHL(c *CSIRAC, k Word) error {
+	src := srcSC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHL(c, k, src)
+}
+
+func execSCToHU(c *CSIRAC, k Word) error {
+	src := srcSC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHU(c, k, src)
+}
+
+func execSCToS(c *CSIRAC, k Word) error {
+	src := srcSC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstS(c, k, src)
+}
+
+func execSCToPS(c *CSIRAC, k Word) error {
+	src := srcSC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPS(c, k, src)
+}
+
+func execSCToCS(c *CSIRAC, k Word) error {
+	src := srcSC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCS(c, k, src)
+}
+
+func execSCToPK(c *CSIRAC, k Word) error {
+	src := srcSC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPK(c, k, src)
+}
+
+func execSCToMA(c *CSIRAC, k Word) error {
+	src := srcSC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMA(c, k, src)
+}
+
+func execSCToMB(c *CSIRAC, k Word) error {
+	src := srcSC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMB(c, k, src)
+}
+
+func execSCToMC(c *CSIRAC, k Word) error {
+	src := srcSC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMC(c, k, src)
+}
+
+func execSCToMD(c *CSIRAC, k Word) error {
+	src := srcSC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMD(c, k, src)
+}
+
+func execSCToT(c *CSIRAC, k Word) error {
+	src := srcSC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstT(c, k, src)
+}
+
+func execRCToM(c *CSIRAC, k Word) error {
+	src := srcRC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstM(c, k, src)
+}
+
+func execRCToQ(c *CSIRAC, k Word) error {
+	src := srcRC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstQ(c, k, src)
+}
+
+func execRCToOT(c *CSIRAC, k Word) error {
+	src := srcRC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOT(c, k, src)
+}
+
+func execRCToOP(c *CSIRAC, k Word) error {
+	src := srcRC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOP(c, k, src)
+}
+
+func execRCToA(c *CSIRAC, k Word) error {
+	src := srcRC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstA(c, k, src)
+}
+
+func execRCToPA(c *CSIRAC, k Word) error {
+	src := srcRC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPA(c, k, src)
+}
+
+func execRCToSA(c *CSIRAC, k Word) error {
+	src := srcRC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSA(c, k, src)
+}
+
+func execRCToCA(c *CSIRAC, k Word) error {
+	src := srcRC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCA(c, k, src)
+}
+
+func execRCToDA(c *CSIRAC, k Word) error {
+	src := srcRC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstDA(c, k, src)
+}
+
+func execRCToNA(c *CSIRAC, k Word) error {
+	src := srcRC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstNA(c, k, src)
+}
+
+func execRCToP(c *CSIRAC, k Word) error {
+	src := srcRC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstP(c, k, src)
+}
+
+func execRCToB(c *CSIRAC, k Word) error {
+	src := srcRC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstB(c, k, src)
+}
+
+func execRCToXB(c *CSIRAC, k Word) error {
+	src := srcRC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstXB(c, k, src)
+}
+
+func execRCToL(c *CSIRAC, k Word) error {
+	src := srcRC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstL(c, k, src)
+}
+
+func execRCToC(c *CSIRAC, k Word) error {
+	src := srcRC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstC(c, k, src)
+}
+
+func execRCToPC(c *CSIRAC, k Word) error {
+	src := srcRC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPC(c, k, src)
+}
+
+func execRCToSC(c *CSIRAC, k Word) error {
+	src := srcRC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSC(c, k, src)
+}
+
+func execRCToD(c *CSIRAC, k Word) error {
+	src := srcRC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstD(c, k, src)
+}
+
+func execRCToPD(c *CSIRAC, k Word) error {
+	src := srcRC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPD(c, k, src)
+}
+
+func execRCToSD(c *CSIRAC, k Word) error {
+	src := srcRC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSD(c, k, src)
+}
+
+func execRCToZ(c *CSIRAC, k Word) error {
+	src := srcRC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstZ(c, k, src)
+}
+
+func execRCToHL(c *CSIRAC, k Word) error {
+	src := srcRC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHL(c, k, src)
+}
+
+func execRCToHU(c *CSIRAC, k Word) error {
+	src := srcRC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHU(c, k, src)
+}
+
+func execRCToS(c *CSIRAC, k Word) error {
+	src := srcRC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstS(c, k, src)
+}
+
+func execRCToPS(c *CSIRAC, k Word) error {
+	src := srcRC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPS(c, k, src)
+}
+
+func execRCToCS(c *CSIRAC, k Word) error {
+	src := srcRC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCS(c, k, src)
+}
+
+func execRCToPK(c *CSIRAC, k Word) error {
+	src := srcRC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPK(c, k, src)
+}
+
+func execRCToMA(c *CSIRAC, k Word) error {
+	src := srcRC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMA(c, k, src)
+}
+
+func execRCToMB(c *CSIRAC, k Word) error {
+	src := srcRC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMB(c, k, src)
+}
+
+func execRCToMC(c *CSIRAC, k Word) error {
+	src := srcRC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMC(c, k, src)
+}
+
+func execRCToMD(c *CSIRAC, k Word) error {
+	src := srcRC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMD(c, k, src)
+}
+
+func execRCToT(c *CSIRAC, k Word) error {
+	src := srcRC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstT(c, k, src)
+}
+
+func execDToM(c *CSIRAC, k Word) error {
+	src := srcD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstM(c, k, src)
+}
+
+func execDToQ(c *CSIRAC, k Word) error {
+	src := srcD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstQ(c, k, src)
+}
+
+func execDToOT(c *CSIRAC, k Word) error {
+	src := srcD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOT(c, k, src)
+}
+
+func execDToOP(c *CSIRAC, k Word) error {
+	src := srcD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOP(c, k, src)
+}
+
+func execDToA(c *CSIRAC, k Word) error {
+	src := srcD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstA(c, k, src)
+}
+
+func execDToPA(c *CSIRAC, k Word) error {
+	src := srcD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPA(c, k, src)
+}
+
+func execDToSA(c *CSIRAC, k Word) error {
+	src := srcD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSA(c, k, src)
+}
+
+func execDToCA(c *CSIRAC, k Word) error {
+	src := srcD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCA(c, k, src)
+}
+
+func execDToDA(c *CSIRAC, k Word) error {
+	src := srcD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstDA(c, k, src)
+}
+
+func execDToNA(c *CSIRAC, k Word) error {
+	src := srcD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstNA(c, k, src)
+}
+
+func execDToP(c *CSIRAC, k Word) error {
+	src := srcD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstP(c, k, src)
+}
+
+func execDToB(c *CSIRAC, k Word) error {
+	src := srcD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstB(c, k, src)
+}
+
+func execDToXB(c *CSIRAC, k Word) error {
+	src := srcD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstXB(c, k, src)
+}
+
+func execDToL(c *CSIRAC, k Word) error {
+	src := srcD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstL(c, k, src)
+}
+
+func execDToC(c *CSIRAC, k Word) error {
+	src := srcD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstC(c, k, src)
+}
+
+func execDToPC(c *CSIRAC, k Word) error {
+	src := srcD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPC(c, k, src)
+}
+
+func execDToSC(c *CSIRAC, k Word) error {
+	src := srcD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSC(c, k, src)
+}
+
+func execDToD(c *CSIRAC, k Word) error {
+	src := srcD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstD(c, k, src)
+}
+
+func execDToPD(c *CSIRAC, k Word) error {
+	src := srcD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPD(c, k, src)
+}
+
+func execDToSD(c *CSIRAC, k Word) error {
+	src := srcD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSD(c, k, src)
+}
+
+func execDToZ(c *CSIRAC, k Word) error {
+	src := srcD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstZ(c, k, src)
+}
+
+func execDToHL(c *CSIRAC, k Word) error {
+	src := srcD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHL(c, k, src)
+}
+
+func execDToHU(c *CSIRAC, k Word) error {
+	src := srcD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHU(c, k, src)
+}
+
+func execDToS(c *CSIRAC, k Word) error {
+	src := srcD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstS(c, k, src)
+}
+
+func execDToPS(c *CSIRAC, k Word) error {
+	src := srcD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPS(c, k, src)
+}
+
+func execDToCS(c *CSIRAC, k Word) error {
+	src := srcD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCS(c, k, src)
+}
+
+func execDToPK(c *CSIRAC, k Word) error {
+	src := srcD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPK(c, k, src)
+}
+
+func execDToMA(c *CSIRAC, k Word) error {
+	src := srcD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMA(c, k, src)
+}
+
+func execDToMB(c *CSIRAC, k Word) error {
+	src := srcD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMB(c, k, src)
+}
+
+func execDToMC(c *CSIRAC, k Word) error {
+	src := srcD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMC(c, k, src)
+}
+
+func execDToMD(c *CSIRAC, k Word) error {
+	src := srcD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMD(c, k, src)
+}
+
+func execDToT(c *CSIRAC, k Word) error {
+	src := srcD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstT(c, k, src)
+}
+
+func execSDToM(c *CSIRAC, k Word) error {
+	src := srcSD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstM(c, k, src)
+}
+
+func execSDToQ(c *CSIRAC, k Word) error {
+	src := srcSD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstQ(c, k, src)
+}
+
+func execSDToOT(c *CSIRAC, k Word) error {
+	src := srcSD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOT(c, k, src)
+}
+
+func execSDToOP(c *CSIRAC, k Word) error {
+	src := srcSD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOP(c, k, src)
+}
+
+func execSDToA(c *CSIRAC, k Word) error {
+	src := srcSD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstA(c, k, src)
+}
+
+func execSDToPA(c *CSIRAC, k Word) error {
+	src := srcSD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPA(c, k, src)
+}
+
+func execSDToSA(c *CSIRAC, k Word) error {
+	src := srcSD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSA(c, k, src)
+}
+
+func execSDToCA(c *CSIRAC, k Word) error {
+	src := srcSD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCA(c, k, src)
+}
+
+func execSDToDA(c *CSIRAC, k Word) error {
+	src := srcSD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstDA(c, k, src)
+}
+
+func execSDToNA(c *CSIRAC, k Word) error {
+	src := srcSD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstNA(c, k, src)
+}
+
+func execSDToP(c *CSIRAC, k Word) error {
+	src := srcSD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstP(c, k, src)
+}
+
+func execSDToB(c *CSIRAC, k Word) error {
+	src := srcSD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstB(c, k, src)
+}
+
+func execSDToXB(c *CSIRAC, k Word) error {
+	src := srcSD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstXB(c, k, src)
+}
+
+func execSDToL(c *CSIRAC, k Word) error {
+	src := srcSD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstL(c, k, src)
+}
+
+func execSDToC(c *CSIRAC, k Word) error {
+	src := srcSD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstC(c, k, src)
+}
+
+func execSDToPC(c *CSIRAC, k Word) error {
+	src := srcSD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPC(c, k, src)
+}
+
+func execSDToSC(c *CSIRAC, k Word) error {
+	src := srcSD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSC(c, k, src)
+}
+
+func execSDToD(c *CSIRAC, k Word) error {
+	src := srcSD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstD(c, k, src)
+}
+
+func execSDToPD(c *CSIRAC, k Word) error {
+	src := srcSD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPD(c, k, src)
+}
+
+func execSDToSD(c *CSIRAC, k Word) error {
+	src := srcSD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSD(c, k, src)
+}
+
+func execSDToZ(c *CSIRAC, k Word) error {
+	src := srcSD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstZ(c, k, src)
+}
+
+func execSDToHL(c *CSIRAC, k Word) error {
+	src := srcSD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHL(c, k, src)
+}
+
+func execSDToHU(c *CSIRAC, k Word) error {
+	src := srcSD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHU(c, k, src)
+}
+
+func execSDToS(c *CSIRAC, k Word) error {
+	src := srcSD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstS(c, k, src)
+}
+
+func execSDToPS(c *CSIRAC, k Word) error {
+	src := srcSD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPS(c, k, src)
+}
+
+func execSDToCS(c *CSIRAC, k Word) error {
+	src := srcSD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCS(c, k, src)
+}
+
+func execSDToPK(c *CSIRAC, k Word) error {
+	src := srcSD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPK(c, k, src)
+}
+
+func execSDToMA(c *CSIRAC, k Word) error {
+	src := srcSD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMA(c, k, src)
+}
+
+func execSDToMB(c *CSIRAC, k Word) error {
+	src := srcSD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMB(c, k, src)
+}
+
+func execSDToMC(c *CSIRAC, k Word) error {
+	src := srcSD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMC(c, k, src)
+}
+
+func execSDToMD(c *CSIRAC, k Word) error {
+	src := srcSD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMD(c, k, src)
+}
+
+func execSDToT(c *CSIRAC, k Word) error {
+	src := srcSD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstT(c, k, src)
+}
+
+func execRDToM(c *CSIRAC, k Word) error {
+	src := srcRD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstM(c, k, src)
+}
+
+func execRDToQ(c *CSIRAC, k Word) error {
+	src := srcRD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstQ(c, k, src)
+}
+
+func execRDToOT(c *CSIRAC, k Word) error {
+	src := srcRD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOT(c, k, src)
+}
+
+func execRDToOP(c *CSIRAC, k Word) error {
+	src := srcRD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOP(c, k, src)
+}
+
+func execRDToA(c *CSIRAC, k Word) error {
+	src := srcRD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstA(c, k, src)
+}
+
+func execRDToPA(c *CSIRAC, k Word) error {
+	src := srcRD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPA(c, k, src)
+}
+
+func execRDToSA(c *CSIRAC, k Word) error {
+	src := srcRD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSA(c, k, src)
+}
+
+func execRDToCA(c *CSIRAC, k Word) error {
+	src := srcRD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCA(c, k, src)
+}
+
+func execRDToDA(c *CSIRAC, k Word) error {
+	src := srcRD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstDA(c, k, src)
+}
+
+func execRDToNA(c *CSIRAC, k Word) error {
+	src := srcRD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstNA(c, k, src)
+}
+
+func execRDToP(c *CSIRAC, k Word) error {
+	src := srcRD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstP(c, k, src)
+}
+
+func execRDToB(c *CSIRAC, k Word) error {
+	src := srcRD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstB(c, k, src)
+}
+
+func execRDToXB(c *CSIRAC, k Word) error {
+	src := srcRD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstXB(c, k, src)
+}
+
+func execRDToL(c *CSIRAC, k Word) error {
+	src := srcRD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstL(c, k, src)
+}
+
+func execRDToC(c *CSIRAC, k Word) error {
+	src := srcRD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstC(c, k, src)
+}
+
+func execRDToPC(c *CSIRAC, k Word) error {
+	src := srcRD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPC(c, k, src)
+}
+
+func execRDToSC(c *CSIRAC, k Word) error {
+	src := srcRD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSC(c, k, src)
+}
+
+func execRDToD(c *CSIRAC, k Word) error {
+	src := srcRD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstD(c, k, src)
+}
+
+func execRDToPD(c *CSIRAC, k Word) error {
+	src := srcRD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPD(c, k, src)
+}
+
+func execRDToSD(c *CSIRAC, k Word) error {
+	src := srcRD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSD(c, k, src)
+}
+
+func execRDToZ(c *CSIRAC, k Word) error {
+	src := srcRD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstZ(c, k, src)
+}
+
+func execRDToHL(c *CSIRAC, k Word) error {
+	src := srcRD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHL(c, k, src)
+}
+
+func execRDToHU(c *CSIRAC, k Word) error {
+	src := srcRD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHU(c, k, src)
+}
+
+func execRDToS(c *CSIRAC, k Word) error {
+	src := srcRD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstS(c, k, src)
+}
+
+func execRDToPS(c *CSIRAC, k Word) error {
+	src := srcRD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPS(c, k, src)
+}
+
+func execRDToCS(c *CSIRAC, k Word) error {
+	src := srcRD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCS(c, k, src)
+}
+
+func execRDToPK(c *CSIRAC, k Word) error {
+	src := srcRD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPK(c, k, src)
+}
+
+func execRDToMA(c *CSIRAC, k Word) error {
+	src := srcRD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMA(c, k, src)
+}
+
+func execRDToMB(c *CSIRAC, k Word) error {
+	src := srcRD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMB(c, k, src)
+}
+
+func execRDToMC(c *CSIRAC, k Word) error {
+	src := srcRD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMC(c, k, src)
+}
+
+func execRDToMD(c *CSIRAC, k Word) error {
+	src := srcRD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMD(c, k, src)
+}
+
+func execRDToT(c *CSIRAC, k Word) error {
+	src := srcRD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstT(c, k, src)
+}
+
+func execZToM(c *CSIRAC, k Word) error {
+	src := srcZ(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstM(c, k, src)
+}
+
+func execZToQ(c *CSIRAC, k Word) error {
+	src := srcZ(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstQ(c, k, src)
+}
+
+func execZToOT(c *CSIRAC, k Word) error {
+	src := srcZ(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOT(c, k, src)
+}
+
+func execZToOP(c *CSIRAC, k Word) error {
+	src := srcZ(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOP(c, k, src)
+}
+
+func execZToA(c *CSIRAC, k Word) error {
+	src := srcZ(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstA(c, k, src)
+}
+
+func execZToPA(c *CSIRAC, k Word) error {
+	src := srcZ(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPA(c, k, src)
+}
+
+func execZToSA(c *CSIRAC, k Word) error {
+	src := srcZ(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSA(c, k, src)
+}
+
+func execZToCA(c *CSIRAC, k Word) error {
+	src := srcZ(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCA(c, k, src)
+}
+
+func execZToDA(c *CSIRAC, k Word) error {
+	src := srcZ(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstDA(c, k, src)
+}
+
+func execZToNA(c *CSIRAC, k Word) error {
+	src := srcZ(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstNA(c, k, src)
+}
+
+func execZToP(c *CSIRAC, k Word) error {
+	src := srcZ(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstP(c, k, src)
+}
+
+func execZToB(c *CSIRAC, k Word) error {
+	src := srcZ(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstB(c, k, src)
+}
+
+func execZToXB(c *CSIRAC, k Word) error {
+	src := srcZ(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstXB(c, k, src)
+}
+
+func execZToL(c *CSIRAC, k Word) error {
+	src := srcZ(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstL(c, k, src)
+}
+
+func execZToC(c *CSIRAC, k Word) error {
+	src := srcZ(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstC(c, k, src)
+}
+
+func execZToPC(c *CSIRAC, k Word) error {
+	src := srcZ(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPC(c, k, src)
+}
+
+func execZToSC(c *CSIRAC, k Word) error {
+	src := srcZ(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSC(c, k, src)
+}
+
+func execZToD(c *CSIRAC, k Word) error {
+	src := srcZ(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstD(c, k, src)
+}
+
+func execZToPD(c *CSIRAC, k Word) error {
+	src := srcZ(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPD(c, k, src)
+}
+
+func execZToSD(c *CSIRAC, k Word) error {
+	src := srcZ(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSD(c, k, src)
+}
+
+func execZToZ(c *CSIRAC, k Word) error {
+	src := srcZ(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstZ(c, k, src)
+}
+
+func execZToHL(c *CSIRAC, k Word) error {
+	src := srcZ(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHL(c, k, src)
+}
+
+func execZToHU(c *CSIRAC, k Word) error {
+	src := srcZ(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHU(c, k, src)
+}
+
+func execZToS(c *CSIRAC, k Word) error {
+	src := srcZ(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstS(c, k, src)
+}
+
+func execZToPS(c *CSIRAC, k Word) error {
+	src := srcZ(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPS(c, k, src)
+}
+
+func execZToCS(c *CSIRAC, k Word) error {
+	src := srcZ(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCS(c, k, src)
+}
+
+func execZToPK(c *CSIRAC, k Word) error {
+	src := srcZ(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPK(c, k, src)
+}
+
+func execZToMA(c *CSIRAC, k Word) error {
+	src := srcZ(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMA(c, k, src)
+}
+
+func execZToMB(c *CSIRAC, k Word) error {
+	src := srcZ(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMB(c, k, src)
+}
+
+func execZToMC(c *CSIRAC, k Word) error {
+	src := srcZ(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMC(c, k, src)
+}
+
+func execZToMD(c *CSIRAC, k Word) error {
+	src := srcZ(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMD(c, k, src)
+}
+
+func execZToT(c *CSIRAC, k Word) error {
+	src := srcZ(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstT(c, k, src)
+}
+
+func execHLToM(c *CSIRAC, k Word) error {
+	src := srcHL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstM(c, k, src)
+}
+
+func execHLToQ(c *CSIRAC, k Word) error {
+	src := srcHL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstQ(c, k, src)
+}
+
+func execHLToOT(c *CSIRAC, k Word) error {
+	src := srcHL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOT(c, k, src)
+}
+
+func execHLToOP(c *CSIRAC, k Word) error {
+	src := srcHL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOP(c, k, src)
+}
+
+func execHLToA(c *CSIRAC, k Word) error {
+	src := srcHL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstA(c, k, src)
+}
+
+func execHLToPA(c *CSIRAC, k Word) error {
+	src := srcHL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPA(c, k, src)
+}
+
+func execHLToSA(c *CSIRAC, k Word) error {
+	src := srcHL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSA(c, k, src)
+}
+
+func execHLToCA(c *CSIRAC, k Word) error {
+	src := srcHL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCA(c, k, src)
+}
+
+func execHLToDA(c *CSIRAC, k Word) error {
+	src := srcHL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstDA(c, k, src)
+}
+
+func execHLToNA(c *CSIRAC, k Word) error {
+	src := srcHL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstNA(c, k, src)
+}
+
+func execHLToP(c *CSIRAC, k Word) error {
+	src := srcHL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstP(c, k, src)
+}
+
+func execHLToB(c *CSIRAC, k Word) error {
+	src := srcHL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstB(c, k, src)
+}
+
+func execHLToXB(c *CSIRAC, k Word) error {
+	src := srcHL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstXB(c, k, src)
+}
+
+func execHLToL(c *CSIRAC, k Word) error {
+	src := srcHL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstL(c, k, src)
+}
+
+func execHLToC(c *CSIRAC, k Word) error {
+	src := srcHL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstC(c, k, src)
+}
+
+func execHLToPC(c *CSIRAC, k Word) error {
+	src := srcHL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPC(c, k, src)
+}
+
+func execHLToSC(c *CSIRAC, k Word) error {
+	src := srcHL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSC(c, k, src)
+}
+
+func execHLToD(c *CSIRAC, k Word) error {
+	src := srcHL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstD(c, k, src)
+}
+
+func execHLToPD(c *CSIRAC, k Word) error {
+	src := srcHL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPD(c, k, src)
+}
+
+func execHLToSD(c *CSIRAC, k Word) error {
+	src := srcHL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSD(c, k, src)
+}
+
+func execHLToZ(c *CSIRAC, k Word) error {
+	src := srcHL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstZ(c, k, src)
+}
+
+func execHLToHL(c *CSIRAC, k Word) error {
+	src := srcHL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHL(c, k, src)
+}
+
+func execHLToHU(c *CSIRAC, k Word) error {
+	src := srcHL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHU(c, k, src)
+}
+
+func execHLToS(c *CSIRAC, k Word) error {
+	src := srcHL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstS(c, k, src)
+}
+
+func execHLToPS(c *CSIRAC, k Word) error {
+	src := srcHL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPS(c, k, src)
+}
+
+func execHLToCS(c *CSIRAC, k Word) error {
+	src := srcHL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCS(c, k, src)
+}
+
+func execHLToPK(c *CSIRAC, k Word) error {
+	src := srcHL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPK(c, k, src)
+}
+
+func execHLToMA(c *CSIRAC, k Word) error {
+	src := srcHL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMA(c, k, src)
+}
+
+func execHLToMB(c *CSIRAC, k Word) error {
+	src := srcHL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMB(c, k, src)
+}
+
+func execHLToMC(c *CSIRAC, k Word) error {
+	src := srcHL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMC(c, k, src)
+}
+
+func execHLToMD(c *CSIRAC, k Word) error {
+	src := srcHL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMD(c, k, src)
+}
+
+func execHLToT(c *CSIRAC, k Word) error {
+	src := srcHL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstT(c, k, src)
+}
+
+func execHUToM(c *CSIRAC, k Word) error {
+	src := srcHU(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstM(c, k, src)
+}
+
+func execHUToQ(c *CSIRAC, k Word) error {
+	src := srcHU(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstQ(c, k, src)
+}
+
+func execHUToOT(c *CSIRAC, k Word) error {
+	src := srcHU(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOT(c, k, src)
+}
+
+func execHUToOP(c *CSIRAC, k Word) error {
+	src := srcHU(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOP(c, k, src)
+}
+
+func execHUToA(c *CSIRAC, k Word) error {
+	src := srcHU(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstA(c, k, src)
+}
+
+func execHUToPA(c *CSIRAC, k Word) error {
+	src := srcHU(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPA(c, k, src)
+}
+
+func execHUToSA(c *CSIRAC, k Word) error {
+	src := srcHU(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSA(c, k, src)
+}
+
+func execHUToCA(c *CSIRAC, k Word) error {
+	src := srcHU(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCA(c, k, src)
+}
+
+func execHUToDA(c *CSIRAC, k Word) error {
+	src := srcHU(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstDA(c, k, src)
+}
+
+func execHUToNA(c *CSIRAC, k Word) error {
+	src := srcHU(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstNA(c, k, src)
+}
+
+func execHUToP(c *CSIRAC, k Word) error {
+	src := srcHU(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstP(c, k, src)
+}
+
+func execHUToB(c *CSIRAC, k Word) error {
+	src := srcHU(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstB(c, k, src)
+}
+
+func execHUToXB(c *CSIRAC, k Word) error {
+	src := srcHU(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstXB(c, k, src)
+}
+
+func execHUToL(c *CSIRAC, k Word) error {
+	src := srcHU(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstL(c, k, src)
+}
+
+func execHUToC(c *CSIRAC, k Word) error {
+	src := srcHU(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstC(c, k, src)
+}
+
+func execHUToPC(c *CSIRAC, k Word) error {
+	src := srcHU(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPC(c, k, src)
+}
+
+func execHUToSC(c *CSIRAC, k Word) error {
+	src := srcHU(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSC(c, k, src)
+}
+
+func execHUToD(c *CSIRAC, k Word) error {
+	src := srcHU(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstD(c, k, src)
+}
+
+func execHUToPD(c *CSIRAC, k Word) error {
+	src := srcHU(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPD(c, k, src)
+}
+
+func execHUToSD(c *CSIRAC, k Word) error {
+	src := srcHU(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSD(c, k, src)
+}
+
+func execHUToZ(c *CSIRAC, k Word) error {
+	src := srcHU(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstZ(c, k, src)
+}
+
+func execHUToHL(c *CSIRAC, k Word) error {
+	src := srcHU(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHL(c, k, src)
+}
+
+func execHUToHU(c *CSIRAC, k Word) error {
+	src := srcHU(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHU(c, k, src)
+}
+
+func execHUToS(c *CSIRAC, k Word) error {
+	src := srcHU(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstS(c, k, src)
+}
+
+func execHUToPS(c *CSIRAC, k Word) error {
+	src := srcHU(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPS(c, k, src)
+}
+
+func execHUToCS(c *CSIRAC, k Word) error {
+	src := srcHU(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCS(c, k, src)
+}
+
+func execHUToPK(c *CSIRAC, k Word) error {
+	src := srcHU(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPK(c, k, src)
+}
+
+func execHUToMA(c *CSIRAC, k Word) error {
+	src := srcHU(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMA(c, k, src)
+}
+
+func execHUToMB(c *CSIRAC, k Word) error {
+	src := srcHU(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMB(c, k, src)
+}
+
+func execHUToMC(c *CSIRAC, k Word) error {
+	src := srcHU(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMC(c, k, src)
+}
+
+func execHUToMD(c *CSIRAC, k Word) error {
+	src := srcHU(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMD(c, k, src)
+}
+
+func execHUToT(c *CSIRAC, k Word) error {
+	src := srcHU(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstT(c, k, src)
+}
+
+func execSToM(c *CSIRAC, k Word) error {
+	src := srcS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstM(c, k, src)
+}
+
+func execSToQ(c *CSIRAC, k Word) error {
+	src := srcS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstQ(c, k, src)
+}
+
+func execSToOT(c *CSIRAC, k Word) error {
+	src := srcS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOT(c, k, src)
+}
+
+func execSToOP(c *CSIRAC, k Word) error {
+	src := srcS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOP(c, k, src)
+}
+
+func execSToA(c *CSIRAC, k Word) error {
+	src := srcS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstA(c, k, src)
+}
+
+func execSToPA(c *CSIRAC, k Word) error {
+	src := srcS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPA(c, k, src)
+}
+
+func execSToSA(c *CSIRAC, k Word) error {
+	src := srcS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSA(c, k, src)
+}
+
+func execSToCA(c *CSIRAC, k Word) error {
+	src := srcS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCA(c, k, src)
+}
+
+func execSToDA(c *CSIRAC, k Word) error {
+	src := srcS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstDA(c, k, src)
+}
+
+func execSToNA(c *CSIRAC, k Word) error {
+	src := srcS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstNA(c, k, src)
+}
+
+func execSToP(c *CSIRAC, k Word) error {
+	src := srcS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstP(c, k, src)
+}
+
+func execSToB(c *CSIRAC, k Word) error {
+	src := srcS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstB(c, k, src)
+}
+
+func execSToXB(c *CSIRAC, k Word) error {
+	src := srcS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstXB(c, k, src)
+}
+
+func execSToL(c *CSIRAC, k Word) error {
+	src := srcS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstL(c, k, src)
+}
+
+func execSToC(c *CSIRAC, k Word) error {
+	src := srcS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstC(c, k, src)
+}
+
+func execSToPC(c *CSIRAC, k Word) error {
+	src := srcS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPC(c, k, src)
+}
+
+func execSToSC(c *CSIRAC, k Word) error {
+	src := srcS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSC(c, k, src)
+}
+
+func execSToD(c *CSIRAC, k Word) error {
+	src := srcS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstD(c, k, src)
+}
+
+func execSToPD(c *CSIRAC, k Word) error {
+	src := srcS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPD(c, k, src)
+}
+
+func execSToSD(c *CSIRAC, k Word) error {
+	src := srcS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSD(c, k, src)
+}
+
+func execSToZ(c *CSIRAC, k Word) error {
+	src := srcS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstZ(c, k, src)
+}
+
+func execSToHL(c *CSIRAC, k Word) error {
+	src := srcS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHL(c, k, src)
+}
+
+func execSToHU(c *CSIRAC, k Word) error {
+	src := srcS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHU(c, k, src)
+}
+
+func execSToS(c *CSIRAC, k Word) error {
+	src := srcS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstS(c, k, src)
+}
+
+func execSToPS(c *CSIRAC, k Word) error {
+	src := srcS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPS(c, k, src)
+}
+
+func execSToCS(c *CSIRAC, k Word) error {
+	src := srcS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCS(c, k, src)
+}
+
+func execSToPK(c *CSIRAC, k Word) error {
+	src := srcS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPK(c, k, src)
+}
+
+func execSToMA(c *CSIRAC, k Word) error {
+	src := srcS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMA(c, k, src)
+}
+
+func execSToMB(c *CSIRAC, k Word) error {
+	src := srcS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMB(c, k, src)
+}
+
+func execSToMC(c *CSIRAC, k Word) error {
+	src := srcS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMC(c, k, src)
+}
+
+func execSToMD(c *CSIRAC, k Word) error {
+	src := srcS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMD(c, k, src)
+}
+
+func execSToT(c *CSIRAC, k Word) error {
+	src := srcS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstT(c, k, src)
+}
+
+func execPEToM(c *CSIRAC, k Word) error {
+	src := srcPE(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstM(c, k, src)
+}
+
+func execPEToQ(c *CSIRAC, k Word) error {
+	src := srcPE(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstQ(c, k, src)
+}
+
+func execPEToOT(c *CSIRAC, k Word) error {
+	src := srcPE(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOT(c, k, src)
+}
+
+func execPEToOP(c *CSIRAC, k Word) error {
+	src := srcPE(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOP(c, k, src)
+}
+
+func execPEToA(c *CSIRAC, k Word) error {
+	src := srcPE(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstA(c, k, src)
+}
+
+func execPEToPA(c *CSIRAC, k Word) error {
+	src := srcPE(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPA(c, k, src)
+}
+
+func execPEToSA(c *CSIRAC, k Word) error {
+	src := srcPE(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSA(c, k, src)
+}
+
+func execPEToCA(c *CSIRAC, k Word) error {
+	src := srcPE(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCA(c, k, src)
+}
+
+func execPEToDA(c *CSIRAC, k Word) error {
+	src := srcPE(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstDA(c, k, src)
+}
+
+func execPEToNA(c *CSIRAC, k Word) error {
+	src := srcPE(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstNA(c, k, src)
+}
+
+func execPEToP(c *CSIRAC, k Word) error {
+	src := srcPE(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstP(c, k, src)
+}
+
+func execPEToB(c *CSIRAC, k Word) error {
+	src := srcPE(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstB(c, k, src)
+}
+
+func execPEToXB(c *CSIRAC, k Word) error {
+	src := srcPE(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstXB(c, k, src)
+}
+
+func execPEToL(c *CSIRAC, k Word) error {
+	src := srcPE(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstL(c, k, src)
+}
+
+func execPEToC(c *CSIRAC, k Word) error {
+	src := srcPE(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstC(c, k, src)
+}
+
+func execPEToPC(c *CSIRAC, k Word) error {
+	src := srcPE(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPC(c, k, src)
+}
+
+func execPEToSC(c *CSIRAC, k Word) error {
+	src := srcPE(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSC(c, k, src)
+}
+
+func execPEToD(c *CSIRAC, k Word) error {
+	src := srcPE(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstD(c, k, src)
+}
+
+func execPEToPD(c *CSIRAC, k Word) error {
+	src := srcPE(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPD(c, k, src)
+}
+
+func execPEToSD(c *CSIRAC, k Word) error {
+	src := srcPE(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSD(c, k, src)
+}
+
+func execPEToZ(c *CSIRAC, k Word) error {
+	src := srcPE(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstZ(c, k, src)
+}
+
+func execPEToHL(c *CSIRAC, k Word) error {
+	src := srcPE(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHL(c, k, src)
+}
+
+func execPEToHU(c *CSIRAC, k Word) error {
+	src := srcPE(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHU(c, k, src)
+}
+
+func execPEToS(c *CSIRAC, k Word) error {
+	src := srcPE(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstS(c, k, src)
+}
+
+func execPEToPS(c *CSIRAC, k Word) error {
+	src := srcPE(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPS(c, k, src)
+}
+
+func execPEToCS(c *CSIRAC, k Word) error {
+	src := srcPE(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCS(c, k, src)
+}
+
+func execPEToPK(c *CSIRAC, k Word) error {
+	src := srcPE(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPK(c, k, src)
+}
+
+func execPEToMA(c *CSIRAC, k Word) error {
+	src := srcPE(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMA(c, k, src)
+}
+
+func execPEToMB(c *CSIRAC, k Word) error {
+	src := srcPE(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMB(c, k, src)
+}
+
+func execPEToMC(c *CSIRAC, k Word) error {
+	src := srcPE(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMC(c, k, src)
+}
+
+func execPEToMD(c *CSIRAC, k Word) error {
+	src := srcPE(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMD(c, k, src)
+}
+
+func execPEToT(c *CSIRAC, k Word) error {
+	src := srcPE(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstT(c, k, src)
+}
+
+func execPLToM(c *CSIRAC, k Word) error {
+	src := srcPL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstM(c, k, src)
+}
+
+func execPLToQ(c *CSIRAC, k Word) error {
+	src := srcPL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstQ(c, k, src)
+}
+
+func execPLToOT(c *CSIRAC, k Word) error {
+	src := srcPL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOT(c, k, src)
+}
+
+func execPLToOP(c *CSIRAC, k Word) error {
+	src := srcPL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOP(c, k, src)
+}
+
+func execPLToA(c *CSIRAC, k Word) error {
+	src := srcPL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstA(c, k, src)
+}
+
+func execPLToPA(c *CSIRAC, k Word) error {
+	src := srcPL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPA(c, k, src)
+}
+
+func execPLToSA(c *CSIRAC, k Word) error {
+	src := srcPL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSA(c, k, src)
+}
+
+func execPLToCA(c *CSIRAC, k Word) error {
+	src := srcPL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCA(c, k, src)
+}
+
+func execPLToDA(c *CSIRAC, k Word) error {
+	src := srcPL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstDA(c, k, src)
+}
+
+func execPLToNA(c *CSIRAC, k Word) error {
+	src := srcPL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstNA(c, k, src)
+}
+
+func execPLToP(c *CSIRAC, k Word) error {
+	src := srcPL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstP(c, k, src)
+}
+
+func execPLToB(c *CSIRAC, k Word) error {
+	src := srcPL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstB(c, k, src)
+}
+
+func execPLToXB(c *CSIRAC, k Word) error {
+	src := srcPL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstXB(c, k, src)
+}
+
+func execPLToL(c *CSIRAC, k Word) error {
+	src := srcPL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstL(c, k, src)
+}
+
+func execPLToC(c *CSIRAC, k Word) error {
+	src := srcPL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstC(c, k, src)
+}
+
+func execPLToPC(c *CSIRAC, k Word) error {
+	src := srcPL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPC(c, k, src)
+}
+
+func execPLToSC(c *CSIRAC, k Word) error {
+	src := srcPL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSC(c, k, src)
+}
+
+func execPLToD(c *CSIRAC, k Word) error {
+	src := srcPL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstD(c, k, src)
+}
+
+func execPLToPD(c *CSIRAC, k Word) error {
+	src := srcPL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPD(c, k, src)
+}
+
+func execPLToSD(c *CSIRAC, k Word) error {
+	src := srcPL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSD(c, k, src)
+}
+
+func execPLToZ(c *CSIRAC, k Word) error {
+	src := srcPL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstZ(c, k, src)
+}
+
+func execPLToHL(c *CSIRAC, k Word) error {
+	src := srcPL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHL(c, k, src)
+}
+
+func execPLToHU(c *CSIRAC, k Word) error {
+	src := srcPL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHU(c, k, src)
+}
+
+func execPLToS(c *CSIRAC, k Word) error {
+	src := srcPL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstS(c, k, src)
+}
+
+func execPLToPS(c *CSIRAC, k Word) error {
+	src := srcPL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPS(c, k, src)
+}
+
+func execPLToCS(c *CSIRAC, k Word) error {
+	src := srcPL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCS(c, k, src)
+}
+
+func execPLToPK(c *CSIRAC, k Word) error {
+	src := srcPL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPK(c, k, src)
+}
+
+func execPLToMA(c *CSIRAC, k Word) error {
+	src := srcPL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMA(c, k, src)
+}
+
+func execPLToMB(c *CSIRAC, k Word) error {
+	src := srcPL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMB(c, k, src)
+}
+
+func execPLToMC(c *CSIRAC, k Word) error {
+	src := srcPL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMC(c, k, src)
+}
+
+func execPLToMD(c *CSIRAC, k Word) error {
+	src := srcPL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMD(c, k, src)
+}
+
+func execPLToT(c *CSIRAC, k Word) error {
+	src := srcPL(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstT(c, k, src)
+}
+
+func execKToM(c *CSIRAC, k Word) error {
+	src := srcK(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstM(c, k, src)
+}
+
+func execKToQ(c *CSIRAC, k Word) error {
+	src := srcK(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstQ(c, k, src)
+}
+
+func execKToOT(c *CSIRAC, k Word) error {
+	src := srcK(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOT(c, k, src)
+}
+
+func execKToOP(c *CSIRAC, k Word) error {
+	src := srcK(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOP(c, k, src)
+}
+
+func execKToA(c *CSIRAC, k Word) error {
+	src := srcK(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstA(c, k, src)
+}
+
+func execKToPA(c *CSIRAC, k Word) error {
+	src := srcK(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPA(c, k, src)
+}
+
+func execKToSA(c *CSIRAC, k Word) error {
+	src := srcK(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSA(c, k, src)
+}
+
+func execKToCA(c *CSIRAC, k Word) error {
+	src := srcK(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCA(c, k, src)
+}
+
+func execKToDA(c *CSIRAC, k Word) error {
+	src := srcK(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstDA(c, k, src)
+}
+
+func execKToNA(c *CSIRAC, k Word) error {
+	src := srcK(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstNA(c, k, src)
+}
+
+func execKToP(c *CSIRAC, k Word) error {
+	src := srcK(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstP(c, k, src)
+}
+
+func execKToB(c *CSIRAC, k Word) error {
+	src := srcK(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstB(c, k, src)
+}
+
+func execKToXB(c *CSIRAC, k Word) error {
+	src := srcK(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstXB(c, k, src)
+}
+
+func execKToL(c *CSIRAC, k Word) error {
+	src := srcK(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstL(c, k, src)
+}
+
+func execKToC(c *CSIRAC, k Word) error {
+	src := srcK(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstC(c, k, src)
+}
+
+func execKToPC(c *CSIRAC, k Word) error {
+	src := srcK(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPC(c, k, src)
+}
+
+func execKToSC(c *CSIRAC, k Word) error {
+	src := srcK(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSC(c, k, src)
+}
+
+func execKToD(c *CSIRAC, k Word) error {
+	src := srcK(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstD(c, k, src)
+}
+
+func execKToPD(c *CSIRAC, k Word) error {
+	src := srcK(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPD(c, k, src)
+}
+
+func execKToSD(c *CSIRAC, k Word) error {
+	src := srcK(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSD(c, k, src)
+}
+
+func execKToZ(c *CSIRAC, k Word) error {
+	src := srcK(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstZ(c, k, src)
+}
+
+func execKToHL(c *CSIRAC, k Word) error {
+	src := srcK(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHL(c, k, src)
+}
+
+func execKToHU(c *CSIRAC, k Word) error {
+	src := srcK(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHU(c, k, src)
+}
+
+func execKToS(c *CSIRAC, k Word) error {
+	src := srcK(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstS(c, k, src)
+}
+
+func execKToPS(c *CSIRAC, k Word) error {
+	src := srcK(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPS(c, k, src)
+}
+
+func execKToCS(c *CSIRAC, k Word) error {
+	src := srcK(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCS(c, k, src)
+}
+
+func execKToPK(c *CSIRAC, k Word) error {
+	src := srcK(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPK(c, k, src)
+}
+
+func execKToMA(c *CSIRAC, k Word) error {
+	src := srcK(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMA(c, k, src)
+}
+
+func execKToMB(c *CSIRAC, k Word) error {
+	src := srcK(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMB(c, k, src)
+}
+
+func execKToMC(c *CSIRAC, k Word) error {
+	src := srcK(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMC(c, k, src)
+}
+
+func execKToMD(c *CSIRAC, k Word) error {
+	src := srcK(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMD(c, k, src)
+}
+
+func execKToT(c *CSIRAC, k Word) error {
+	src := srcK(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstT(c, k, src)
+}
+
+func execMAToM(c *CSIRAC, k Word) error {
+	src := srcMA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstM(c, k, src)
+}
+
+func execMAToQ(c *CSIRAC, k Word) error {
+	src := srcMA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstQ(c, k, src)
+}
+
+func execMAToOT(c *CSIRAC, k Word) error {
+	src := srcMA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOT(c, k, src)
+}
+
+func execMAToOP(c *CSIRAC, k Word) error {
+	src := srcMA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOP(c, k, src)
+}
+
+func execMAToA(c *CSIRAC, k Word) error {
+	src := srcMA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstA(c, k, src)
+}
+
+func execMAToPA(c *CSIRAC, k Word) error {
+	src := srcMA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPA(c, k, src)
+}
+
+func execMAToSA(c *CSIRAC, k Word) error {
+	src := srcMA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSA(c, k, src)
+}
+
+func execMAToCA(c *CSIRAC, k Word) error {
+	src := srcMA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCA(c, k, src)
+}
+
+func execMAToDA(c *CSIRAC, k Word) error {
+	src := srcMA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstDA(c, k, src)
+}
+
+func execMAToNA(c *CSIRAC, k Word) error {
+	src := srcMA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstNA(c, k, src)
+}
+
+func execMAToP(c *CSIRAC, k Word) error {
+	src := srcMA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstP(c, k, src)
+}
+
+func execMAToB(c *CSIRAC, k Word) error {
+	src := srcMA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstB(c, k, src)
+}
+
+func execMAToXB(c *CSIRAC, k Word) error {
+	src := srcMA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstXB(c, k, src)
+}
+
+func execMAToL(c *CSIRAC, k Word) error {
+	src := srcMA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstL(c, k, src)
+}
+
+func execMAToC(c *CSIRAC, k Word) error {
+	src := srcMA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstC(c, k, src)
+}
+
+func execMAToPC(c *CSIRAC, k Word) error {
+	src := srcMA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPC(c, k, src)
+}
+
+func execMAToSC(c *CSIRAC, k Word) error {
+	src := srcMA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSC(c, k, src)
+}
+
+func execMAToD(c *CSIRAC, k Word) error {
+	src := srcMA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstD(c, k, src)
+}
+
+func execMAToPD(c *CSIRAC, k Word) error {
+	src := srcMA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPD(c, k, src)
+}
+
+func execMAToSD(c *CSIRAC, k Word) error {
+	src := srcMA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSD(c, k, src)
+}
+
+func execMAToZ(c *CSIRAC, k Word) error {
+	src := srcMA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstZ(c, k, src)
+}
+
+func execMAToHL(c *CSIRAC, k Word) error {
+	src := srcMA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHL(c, k, src)
+}
+
+func execMAToHU(c *CSIRAC, k Word) error {
+	src := srcMA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHU(c, k, src)
+}
+
+func execMAToS(c *CSIRAC, k Word) error {
+	src := srcMA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstS(c, k, src)
+}
+
+func execMAToPS(c *CSIRAC, k Word) error {
+	src := srcMA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPS(c, k, src)
+}
+
+func execMAToCS(c *CSIRAC, k Word) error {
+	src := srcMA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCS(c, k, src)
+}
+
+func execMAToPK(c *CSIRAC, k Word) error {
+	src := srcMA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPK(c, k, src)
+}
+
+func execMAToMA(c *CSIRAC, k Word) error {
+	src := srcMA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMA(c, k, src)
+}
+
+func execMAToMB(c *CSIRAC, k Word) error {
+	src := srcMA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMB(c, k, src)
+}
+
+func execMAToMC(c *CSIRAC, k Word) error {
+	src := srcMA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMC(c, k, src)
+}
+
+func execMAToMD(c *CSIRAC, k Word) error {
+	src := srcMA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMD(c, k, src)
+}
+
+func execMAToT(c *CSIRAC, k Word) error {
+	src := srcMA(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstT(c, k, src)
+}
+
+func execMBToM(c *CSIRAC, k Word) error {
+	src := srcMB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstM(c, k, src)
+}
+
+func execMBToQ(c *CSIRAC, k Word) error {
+	src := srcMB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstQ(c, k, src)
+}
+
+func execMBToOT(c *CSIRAC, k Word) error {
+	src := srcMB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOT(c, k, src)
+}
+
+func execMBToOP(c *CSIRAC, k Word) error {
+	src := srcMB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOP(c, k, src)
+}
+
+func execMBToA(c *CSIRAC, k Word) error {
+	src := srcMB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstA(c, k, src)
+}
+
+func execMBToPA(c *CSIRAC, k Word) error {
+	src := srcMB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPA(c, k, src)
+}
+
+func execMBToSA(c *CSIRAC, k Word) error {
+	src := srcMB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSA(c, k, src)
+}
+
+func execMBToCA(c *CSIRAC, k Word) error {
+	src := srcMB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCA(c, k, src)
+}
+
+func execMBToDA(c *CSIRAC, k Word) error {
+	src := srcMB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstDA(c, k, src)
+}
+
+func execMBToNA(c *CSIRAC, k Word) error {
+	src := srcMB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstNA(c, k, src)
+}
+
+func execMBToP(c *CSIRAC, k Word) error {
+	src := srcMB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstP(c, k, src)
+}
+
+func execMBToB(c *CSIRAC, k Word) error {
+	src := srcMB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstB(c, k, src)
+}
+
+func execMBToXB(c *CSIRAC, k Word) error {
+	src := srcMB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstXB(c, k, src)
+}
+
+func execMBToL(c *CSIRAC, k Word) error {
+	src := srcMB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstL(c, k, src)
+}
+
+func execMBToC(c *CSIRAC, k Word) error {
+	src := srcMB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstC(c, k, src)
+}
+
+func execMBToPC(c *CSIRAC, k Word) error {
+	src := srcMB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPC(c, k, src)
+}
+
+func execMBToSC(c *CSIRAC, k Word) error {
+	src := srcMB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSC(c, k, src)
+}
+
+func execMBToD(c *CSIRAC, k Word) error {
+	src := srcMB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstD(c, k, src)
+}
+
+func execMBToPD(c *CSIRAC, k Word) error {
+	src := srcMB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPD(c, k, src)
+}
+
+func execMBToSD(c *CSIRAC, k Word) error {
+	src := srcMB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSD(c, k, src)
+}
+
+func execMBToZ(c *CSIRAC, k Word) error {
+	src := srcMB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstZ(c, k, src)
+}
+
+func execMBToHL(c *CSIRAC, k Word) error {
+	src := srcMB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHL(c, k, src)
+}
+
+func execMBToHU(c *CSIRAC, k Word) error {
+	src := srcMB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHU(c, k, src)
+}
+
+func execMBToS(c *CSIRAC, k Word) error {
+	src := srcMB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstS(c, k, src)
+}
+
+func execMBToPS(c *CSIRAC, k Word) error {
+	src := srcMB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPS(c, k, src)
+}
+
+func execMBToCS(c *CSIRAC, k Word) error {
+	src := srcMB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCS(c, k, src)
+}
+
+func execMBToPK(c *CSIRAC, k Word) error {
+	src := srcMB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPK(c, k, src)
+}
+
+func execMBToMA(c *CSIRAC, k Word) error {
+	src := srcMB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMA(c, k, src)
+}
+
+func execMBToMB(c *CSIRAC, k Word) error {
+	src := srcMB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMB(c, k, src)
+}
+
+func execMBToMC(c *CSIRAC, k Word) error {
+	src := srcMB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMC(c, k, src)
+}
+
+func execMBToMD(c *CSIRAC, k Word) error {
+	src := srcMB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMD(c, k, src)
+}
+
+func execMBToT(c *CSIRAC, k Word) error {
+	src := srcMB(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstT(c, k, src)
+}
+
+func execMCToM(c *CSIRAC, k Word) error {
+	src := srcMC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstM(c, k, src)
+}
+
+func execMCToQ(c *CSIRAC, k Word) error {
+	src := srcMC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstQ(c, k, src)
+}
+
+func execMCToOT(c *CSIRAC, k Word) error {
+	src := srcMC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOT(c, k, src)
+}
+
+func execMCToOP(c *CSIRAC, k Word) error {
+	src := srcMC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOP(c, k, src)
+}
+
+func execMCToA(c *CSIRAC, k Word) error {
+	src := srcMC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstA(c, k, src)
+}
+
+func execMCToPA(c *CSIRAC, k Word) error {
+	src := srcMC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPA(c, k, src)
+}
+
+func execMCToSA(c *CSIRAC, k Word) error {
+	src := srcMC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSA(c, k, src)
+}
+
+func execMCToCA(c *CSIRAC, k Word) error {
+	src := srcMC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCA(c, k, src)
+}
+
+func execMCToDA(c *CSIRAC, k Word) error {
+	src := srcMC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstDA(c, k, src)
+}
+
+func execMCToNA(c *CSIRAC, k Word) error {
+	src := srcMC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstNA(c, k, src)
+}
+
+func execMCToP(c *CSIRAC, k Word) error {
+	src := srcMC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstP(c, k, src)
+}
+
+func execMCToB(c *CSIRAC, k Word) error {
+	src := srcMC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstB(c, k, src)
+}
+
+func execMCToXB(c *CSIRAC, k Word) error {
+	src := srcMC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstXB(c, k, src)
+}
+
+func execMCToL(c *CSIRAC, k Word) error {
+	src := srcMC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstL(c, k, src)
+}
+
+func execMCToC(c *CSIRAC, k Word) error {
+	src := srcMC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstC(c, k, src)
+}
+
+func execMCToPC(c *CSIRAC, k Word) error {
+	src := srcMC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPC(c, k, src)
+}
+
+func execMCToSC(c *CSIRAC, k Word) error {
+	src := srcMC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSC(c, k, src)
+}
+
+func execMCToD(c *CSIRAC, k Word) error {
+	src := srcMC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstD(c, k, src)
+}
+
+func execMCToPD(c *CSIRAC, k Word) error {
+	src := srcMC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPD(c, k, src)
+}
+
+func execMCToSD(c *CSIRAC, k Word) error {
+	src := srcMC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSD(c, k, src)
+}
+
+func execMCToZ(c *CSIRAC, k Word) error {
+	src := srcMC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstZ(c, k, src)
+}
+
+func execMCToHL(c *CSIRAC, k Word) error {
+	src := srcMC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHL(c, k, src)
+}
+
+func execMCToHU(c *CSIRAC, k Word) error {
+	src := srcMC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHU(c, k, src)
+}
+
+func execMCToS(c *CSIRAC, k Word) error {
+	src := srcMC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstS(c, k, src)
+}
+
+func execMCToPS(c *CSIRAC, k Word) error {
+	src := srcMC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPS(c, k, src)
+}
+
+func execMCToCS(c *CSIRAC, k Word) error {
+	src := srcMC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCS(c, k, src)
+}
+
+func execMCToPK(c *CSIRAC, k Word) error {
+	src := srcMC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPK(c, k, src)
+}
+
+func execMCToMA(c *CSIRAC, k Word) error {
+	src := srcMC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMA(c, k, src)
+}
+
+func execMCToMB(c *CSIRAC, k Word) error {
+	src := srcMC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMB(c, k, src)
+}
+
+func execMCToMC(c *CSIRAC, k Word) error {
+	src := srcMC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMC(c, k, src)
+}
+
+func execMCToMD(c *CSIRAC, k Word) error {
+	src := srcMC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMD(c, k, src)
+}
+
+func execMCToT(c *CSIRAC, k Word) error {
+	src := srcMC(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstT(c, k, src)
+}
+
+func execMDToM(c *CSIRAC, k Word) error {
+	src := srcMD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstM(c, k, src)
+}
+
+func execMDToQ(c *CSIRAC, k Word) error {
+	src := srcMD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstQ(c, k, src)
+}
+
+func execMDToOT(c *CSIRAC, k Word) error {
+	src := srcMD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOT(c, k, src)
+}
+
+func execMDToOP(c *CSIRAC, k Word) error {
+	src := srcMD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOP(c, k, src)
+}
+
+func execMDToA(c *CSIRAC, k Word) error {
+	src := srcMD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstA(c, k, src)
+}
+
+func execMDToPA(c *CSIRAC, k Word) error {
+	src := srcMD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPA(c, k, src)
+}
+
+func execMDToSA(c *CSIRAC, k Word) error {
+	src := srcMD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSA(c, k, src)
+}
+
+func execMDToCA(c *CSIRAC, k Word) error {
+	src := srcMD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCA(c, k, src)
+}
+
+func execMDToDA(c *CSIRAC, k Word) error {
+	src := srcMD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstDA(c, k, src)
+}
+
+func execMDToNA(c *CSIRAC, k Word) error {
+	src := srcMD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstNA(c, k, src)
+}
+
+func execMDToP(c *CSIRAC, k Word) error {
+	src := srcMD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstP(c, k, src)
+}
+
+func execMDToB(c *CSIRAC, k Word) error {
+	src := srcMD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstB(c, k, src)
+}
+
+func execMDToXB(c *CSIRAC, k Word) error {
+	src := srcMD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstXB(c, k, src)
+}
+
+func execMDToL(c *CSIRAC, k Word) error {
+	src := srcMD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstL(c, k, src)
+}
+
+func execMDToC(c *CSIRAC, k Word) error {
+	src := srcMD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstC(c, k, src)
+}
+
+func execMDToPC(c *CSIRAC, k Word) error {
+	src := srcMD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPC(c, k, src)
+}
+
+func execMDToSC(c *CSIRAC, k Word) error {
+	src := srcMD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSC(c, k, src)
+}
+
+func execMDToD(c *CSIRAC, k Word) error {
+	src := srcMD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstD(c, k, src)
+}
+
+func execMDToPD(c *CSIRAC, k Word) error {
+	src := srcMD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPD(c, k, src)
+}
+
+func execMDToSD(c *CSIRAC, k Word) error {
+	src := srcMD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSD(c, k, src)
+}
+
+func execMDToZ(c *CSIRAC, k Word) error {
+	src := srcMD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstZ(c, k, src)
+}
+
+func execMDToHL(c *CSIRAC, k Word) error {
+	src := srcMD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHL(c, k, src)
+}
+
+func execMDToHU(c *CSIRAC, k Word) error {
+	src := srcMD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHU(c, k, src)
+}
+
+func execMDToS(c *CSIRAC, k Word) error {
+	src := srcMD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstS(c, k, src)
+}
+
+func execMDToPS(c *CSIRAC, k Word) error {
+	src := srcMD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPS(c, k, src)
+}
+
+func execMDToCS(c *CSIRAC, k Word) error {
+	src := srcMD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCS(c, k, src)
+}
+
+func execMDToPK(c *CSIRAC, k Word) error {
+	src := srcMD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPK(c, k, src)
+}
+
+func execMDToMA(c *CSIRAC, k Word) error {
+	src := srcMD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMA(c, k, src)
+}
+
+func execMDToMB(c *CSIRAC, k Word) error {
+	src := srcMD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMB(c, k, src)
+}
+
+func execMDToMC(c *CSIRAC, k Word) error {
+	src := srcMD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMC(c, k, src)
+}
+
+func execMDToMD(c *CSIRAC, k Word) error {
+	src := srcMD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMD(c, k, src)
+}
+
+func execMDToT(c *CSIRAC, k Word) error {
+	src := srcMD(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstT(c, k, src)
+}
+
+func execPSToM(c *CSIRAC, k Word) error {
+	src := srcPS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstM(c, k, src)
+}
+
+func execPSToQ(c *CSIRAC, k Word) error {
+	src := srcPS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstQ(c, k, src)
+}
+
+func execPSToOT(c *CSIRAC, k Word) error {
+	src := srcPS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOT(c, k, src)
+}
+
+func execPSToOP(c *CSIRAC, k Word) error {
+	src := srcPS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstOP(c, k, src)
+}
+
+func execPSToA(c *CSIRAC, k Word) error {
+	src := srcPS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstA(c, k, src)
+}
+
+func execPSToPA(c *CSIRAC, k Word) error {
+	src := srcPS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPA(c, k, src)
+}
+
+func execPSToSA(c *CSIRAC, k Word) error {
+	src := srcPS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSA(c, k, src)
+}
+
+func execPSToCA(c *CSIRAC, k Word) error {
+	src := srcPS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCA(c, k, src)
+}
+
+func execPSToDA(c *CSIRAC, k Word) error {
+	src := srcPS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstDA(c, k, src)
+}
+
+func execPSToNA(c *CSIRAC, k Word) error {
+	src := srcPS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstNA(c, k, src)
+}
+
+func execPSToP(c *CSIRAC, k Word) error {
+	src := srcPS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstP(c, k, src)
+}
+
+func execPSToB(c *CSIRAC, k Word) error {
+	src := srcPS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstB(c, k, src)
+}
+
+func execPSToXB(c *CSIRAC, k Word) error {
+	src := srcPS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstXB(c, k, src)
+}
+
+func execPSToL(c *CSIRAC, k Word) error {
+	src := srcPS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstL(c, k, src)
+}
+
+func execPSToC(c *CSIRAC, k Word) error {
+	src := srcPS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstC(c, k, src)
+}
+
+func execPSToPC(c *CSIRAC, k Word) error {
+	src := srcPS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPC(c, k, src)
+}
+
+func execPSToSC(c *CSIRAC, k Word) error {
+	src := srcPS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSC(c, k, src)
+}
+
+func execPSToD(c *CSIRAC, k Word) error {
+	src := srcPS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstD(c, k, src)
+}
+
+func execPSToPD(c *CSIRAC, k Word) error {
+	src := srcPS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPD(c, k, src)
+}
+
+func execPSToSD(c *CSIRAC, k Word) error {
+	src := srcPS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstSD(c, k, src)
+}
+
+func execPSToZ(c *CSIRAC, k Word) error {
+	src := srcPS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstZ(c, k, src)
+}
+
+func execPSToHL(c *CSIRAC, k Word) error {
+	src := srcPS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHL(c, k, src)
+}
+
+func execPSToHU(c *CSIRAC, k Word) error {
+	src := srcPS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstHU(c, k, src)
+}
+
+func execPSToS(c *CSIRAC, k Word) error {
+	src := srcPS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstS(c, k, src)
+}
+
+func execPSToPS(c *CSIRAC, k Word) error {
+	src := srcPS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPS(c, k, src)
+}
+
+func execPSToCS(c *CSIRAC, k Word) error {
+	src := srcPS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstCS(c, k, src)
+}
+
+func execPSToPK(c *CSIRAC, k Word) error {
+	src := srcPS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstPK(c, k, src)
+}
+
+func execPSToMA(c *CSIRAC, k Word) error {
+	src := srcPS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMA(c, k, src)
+}
+
+func execPSToMB(c *CSIRAC, k Word) error {
+	src := srcPS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMB(c, k, src)
+}
+
+func execPSToMC(c *CSIRAC, k Word) error {
+	src := srcPS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMC(c, k, src)
+}
+
+func execPSToMD(c *CSIRAC, k Word) error {
+	src := srcPS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstMD(c, k, src)
+}
+
+func execPSToT(c *CSIRAC, k Word) error {
+	src := srcPS(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dstT(c, k, src)
+}
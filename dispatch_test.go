@@ -0,0 +1,243 @@
+/*
+   Copyright 2021 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package csirac
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// recorder is a device that records every event.
+type recorder []Event
+
+func (r *recorder) Event(c *CSIRAC, e Event) error {
+	*r = append(*r, e)
+	return nil
+}
+
+// randomMachine returns a machine with random registers, stores and input
+// tape, recording its events in rec. The drums are sometimes short or
+// missing, so that some instructions panic.
+func randomMachine(rng *rand.Rand, rec *recorder) *CSIRAC {
+	word := func() Word { return Word(rng.Intn(1 << 20)) }
+	store := func(n int) []Word {
+		m := make([]Word, n)
+		for i := range m {
+			m[i] = word()
+		}
+		return m
+	}
+	c := &CSIRAC{
+		A: word(), B: word(), C: word(), H: Word(rng.Intn(1 << 10)),
+		S: word(), I: word(),
+		Decimal:  rng.Intn(2) == 0,
+		SignAtP1: rng.Intn(2) == 0,
+		NA:       word(), NB: word(), IS: word(), T: word(),
+		M:  store(1024),
+		MA: store(1024),
+		MB: store(512),
+		MC: store(rng.Intn(2) * 1024),
+		Input: &InputTape{
+			Rows: []uint16{uint16(rng.Intn(1 << 12)), uint16(rng.Intn(1 << 12)), DecimalRow(rng.Intn(10))},
+		},
+	}
+	for i := range c.D {
+		c.D[i] = word()
+	}
+	c.K = c.M[c.S.Hi()]
+	c.Attach(rec)
+	return c
+}
+
+// stepResult is the result of one step.
+type stepResult struct {
+	err   error
+	panic bool
+}
+
+// try runs step, catching any panic.
+func try(c *CSIRAC, step func(*CSIRAC) error) (r stepResult) {
+	defer func() {
+		if recover() != nil {
+			r.panic = true
+		}
+	}()
+	return stepResult{err: step(c)}
+}
+
+// tableStep is Step, decoding the instruction with ReadSource and WriteDest.
+func tableStep(c *CSIRAC) error {
+	c.Instructions++
+	inst := c.K
+	src := c.ReadSource()
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return c.WriteDest(inst, src)
+}
+
+// compare runs n steps on identical random machines, with Step, tableStep,
+// and refStep, and reports any difference from refStep.
+func compare(t *testing.T, seed int64, n int, setup func(*CSIRAC)) {
+	t.Helper()
+	steps := []struct {
+		name string
+		step func(*CSIRAC) error
+	}{
+		{"Step", (*CSIRAC).Step},
+		{"tableStep", tableStep},
+	}
+	for _, s := range steps {
+		var gotOut, wantOut recorder
+		got := randomMachine(rand.New(rand.NewSource(seed)), &gotOut)
+		want := randomMachine(rand.New(rand.NewSource(seed)), &wantOut)
+		if setup != nil {
+			setup(got)
+			setup(want)
+		}
+		for i := 0; i < n; i++ {
+			k := want.K
+			gr := try(got, s.step)
+			wr := try(want, refStep)
+			if gr != wr {
+				t.Fatalf("seed %d step %d (%v): %s() = %+v, refStep() = %+v", seed, i, k.InstructionString(), s.name, gr, wr)
+			}
+			if gr.panic {
+				break
+			}
+			if diff := diffSnapshots(got.Snapshot(), want.Snapshot()); diff != "" {
+				t.Fatalf("seed %d step %d (%v): state after %s() differs from refStep(): %s", seed, i, k.InstructionString(), s.name, diff)
+			}
+			if !reflect.DeepEqual(gotOut, wantOut) {
+				t.Fatalf("seed %d step %d (%v): events after %s() = %v, after refStep() = %v", seed, i, k.InstructionString(), s.name, gotOut, wantOut)
+			}
+		}
+	}
+}
+
+// diffSnapshots describes the first difference between two snapshots, or
+// returns "" if they are the same.
+func diffSnapshots(got, want *Snapshot) string {
+	gv, wv := reflect.ValueOf(*got), reflect.ValueOf(*want)
+	for i := 0; i < gv.NumField(); i++ {
+		g, w := gv.Field(i).Interface(), wv.Field(i).Interface()
+		if reflect.DeepEqual(g, w) {
+			continue
+		}
+		name := gv.Type().Field(i).Name
+		if gs, ok := g.([]Word); ok {
+			ws := w.([]Word)
+			for j := 0; j < len(gs) && j < len(ws); j++ {
+				if gs[j] != ws[j] {
+					return fmt.Sprintf("%s[%d] = %v, want %v", name, j, gs[j], ws[j])
+				}
+			}
+			return fmt.Sprintf("len(%s) = %d, want %d", name, len(gs), len(ws))
+		}
+		return fmt.Sprintf("%s = %+v, want %+v", name, g, w)
+	}
+	return ""
+}
+
+func TestDispatchEveryInstruction(t *testing.T) {
+	// Every combination of source and destination, with random addresses
+	// and machine states.
+	for lo := Word(0); lo < 1024; lo++ {
+		for seed := int64(0); seed < 4; seed++ {
+			lo := lo
+			compare(t, int64(lo)*4+seed, 1, func(c *CSIRAC) {
+				c.K = c.K&hi10 | lo
+			})
+		}
+	}
+}
+
+func TestDispatchRandomPrograms(t *testing.T) {
+	for seed := int64(0); seed < 500; seed++ {
+		compare(t, seed, 200, nil)
+	}
+}
+
+// benchmarkPrograms are loops that run forever.
+var benchmarkPrograms = []struct {
+	name string
+	m    []Word
+}{
+	{
+		name: "CountDown",
+		m: MustParseProgram(`
+			      B PA      ; A += B
+			      PE SC     ; C--
+			      6 M PD    ; D6 += M[6]
+			      SC CS     ; if C < 0 { skip next }
+			      0 K S     ; goto 0
+			      0 K S     ; goto 0
+			      .word 3
+		`),
+	},
+	{
+		name: "PKSum",
+		m: MustParseProgram(`
+			      0 0 A SA  ; A = 0
+			start: 8 K C    ; C = 8
+			loop: C PK      ; next command += C
+			      data M PA ; A += data[C]
+			      PE SC     ; C--
+			      SC CS     ; if C < 0 { skip next }
+			      loop-.-1 K PS ; goto loop
+			      start K S ; goto start
+			data: .word 14, 2, 3, 10, 8, 3, 2, 9, 6
+		`),
+	},
+	{
+		name: "Multiply",
+		m: MustParseProgram(`
+			loop: 3 K C     ; C = 3
+			      128 K XB  ; B = 0.25 * C, A += the upper half
+			      TA HU     ; H = twice A (upper half)
+			      HU D      ; D3 = H
+			      16 4 K L  ; shift A and B left 3 places
+			      loop K S  ; goto loop
+			      .word 0
+		`),
+	},
+}
+
+func benchmarkStep(b *testing.B, ref bool) {
+	for _, p := range benchmarkPrograms {
+		b.Run(p.name, func(b *testing.B) {
+			c := &CSIRAC{M: append([]Word(nil), p.m...)}
+			c.K = c.M[0]
+			b.ResetTimer()
+			var err error
+			for i := 0; i < b.N && err == nil; i++ {
+				if ref {
+					err = refStep(c)
+				} else {
+					err = c.Step()
+				}
+			}
+			if err != nil {
+				b.Fatalf("step() = %v", err)
+			}
+		})
+	}
+}
+
+func BenchmarkStep(b *testing.B)    { benchmarkStep(b, false) }
+func BenchmarkRefStep(b *testing.B) { benchmarkStep(b, true) }
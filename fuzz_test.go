@@ -0,0 +1,200 @@
+//go:build go1.18
+// +build go1.18
+
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package csirac
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// The fuzz targets build a machine from two byte strings, one for the
+// registers and one for the stores and the input tape, and run it for a
+// bounded number of steps. Run them with, for example:
+//
+//	go test -fuzz=FuzzStep -fuzztime=1m
+
+// fuzzSteps is the most steps each fuzz input runs for.
+const fuzzSteps = 2000
+
+// fuzzWords reads 20-bit words, three bytes each, from b. Missing bytes read
+// as zero.
+type fuzzWords []byte
+
+func (f *fuzzWords) next() Word {
+	var w Word
+	for i := 0; i < 3; i++ {
+		w <<= 8
+		if len(*f) > 0 {
+			w |= Word((*f)[0])
+			*f = (*f)[1:]
+		}
+	}
+	return w & allBits
+}
+
+// fuzzMachine returns a machine with full-sized stores (so that every
+// address is in range), built from regs and image. No devices are
+// attached: the machine should cope without them.
+func fuzzMachine(regs, image []byte) *CSIRAC {
+	r := fuzzWords(regs)
+	c := &CSIRAC{
+		A: r.next(), B: r.next(), C: r.next(), H: r.next() & lo10,
+		S: r.next(), I: r.next(),
+		NA: r.next(), NB: r.next(), IS: r.next(), T: r.next(),
+		Budget: Budget{Instructions: fuzzSteps},
+	}
+	for i := range c.D {
+		c.D[i] = r.next()
+	}
+	c.Decimal = r.next()&1 != 0
+
+	// The image fills the main store, then the drums, then the input tape,
+	// repeating as often as needed.
+	if len(image) == 0 {
+		image = []byte{0}
+	}
+	im := fuzzWords(nil)
+	next := func() Word {
+		if len(im) == 0 {
+			im = fuzzWords(image)
+		}
+		return im.next()
+	}
+	for _, st := range []*[]Word{&c.M, &c.MA, &c.MB, &c.MC, &c.MD} {
+		*st = make([]Word, 1024)
+		for i := range *st {
+			(*st)[i] = next()
+		}
+	}
+	c.Input = &InputTape{Rows: make([]uint16, len(image)%64)}
+	for i := range c.Input.Rows {
+		c.Input.Rows[i] = uint16(next() & 0xfff)
+	}
+	c.K = c.M[c.S.Hi()]
+	return c
+}
+
+// checkRegisters reports a register that has more digits than it can hold.
+func checkRegisters(c *CSIRAC) error {
+	all := c.A | c.B | c.C | c.S | c.K | c.I
+	for _, d := range c.D {
+		all |= d
+	}
+	if all&^allBits == 0 && c.H&^lo10 == 0 {
+		return nil
+	}
+	regs := []Word{c.A, c.B, c.C, c.S, c.K, c.I}
+	names := []string{"A", "B", "C", "S", "K", "I"}
+	for i, d := range c.D {
+		regs = append(regs, d)
+		names = append(names, fmt.Sprintf("D%d", i))
+	}
+	for i, w := range regs {
+		if w&^allBits != 0 {
+			return fmt.Errorf("register %s = %#x exceeds 20 bits", names[i], uint32(w))
+		}
+	}
+	return fmt.Errorf("register H = %#x exceeds 10 bits", uint32(c.H))
+}
+
+// fuzzRun runs the machine until it stops or runs out of budget, checking
+// the registers after each step.
+func fuzzRun(t *testing.T, c *CSIRAC) {
+	t.Helper()
+	for {
+		err := c.Step()
+		if err := checkRegisters(c); err != nil {
+			t.Fatalf("after %d steps (K = %s): %v", c.Instructions, c.K.InstructionString(), err)
+		}
+		var be *ErrBudgetExceeded
+		switch {
+		case err == nil:
+			continue
+		case errors.Is(err, ErrStop), errors.As(err, &be):
+			return
+		default:
+			t.Fatalf("after %d steps: Step() = %v", c.Instructions, err)
+		}
+	}
+}
+
+// fuzzSeeds adds some programs to the corpus.
+func fuzzSeeds(f *testing.F) {
+	f.Add([]byte{}, []byte{})
+	for _, prog := range benchmarkPrograms {
+		image := make([]byte, 0, 3*len(prog.m))
+		for _, w := range prog.m {
+			image = append(image, byte(w>>16), byte(w>>8), byte(w))
+		}
+		f.Add([]byte{0, 0, 13, 0, 0, 47}, image)
+	}
+}
+
+func FuzzStep(f *testing.F) {
+	fuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, regs, image []byte) {
+		c := fuzzMachine(regs, image)
+		if err := checkRegisters(c); err != nil {
+			t.Fatalf("fuzzMachine: %v", err)
+		}
+		fuzzRun(t, c)
+	})
+}
+
+func FuzzSnapshot(f *testing.F) {
+	fuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, regs, image []byte) {
+		c := fuzzMachine(regs, image)
+		c.Budget = Budget{Instructions: fuzzSteps / 2}
+		fuzzRun(t, c)
+
+		// The state at any point can be saved and read back...
+		snap := c.Snapshot()
+		var buf bytes.Buffer
+		if err := WriteSnapshot(&buf, snap); err != nil {
+			t.Fatalf("WriteSnapshot() = %v", err)
+		}
+		got, err := ReadSnapshot(&buf)
+		if err != nil {
+			t.Fatalf("ReadSnapshot(WriteSnapshot(snap)) = %v", err)
+		}
+		if !reflect.DeepEqual(got, snap) {
+			t.Fatalf("ReadSnapshot(WriteSnapshot(snap)) = %+v, want %+v", got, snap)
+		}
+
+		// ...and restored into another machine, which then carries on just
+		// as the original does.
+		d := new(CSIRAC)
+		got.Restore(d)
+		if !reflect.DeepEqual(d.Snapshot(), snap) {
+			t.Fatalf("Restore(snap).Snapshot() = %+v, want %+v", d.Snapshot(), snap)
+		}
+		c.Budget = Budget{Instructions: fuzzSteps}
+		d.Budget = c.Budget
+		fuzzRun(t, c)
+		fuzzRun(t, d)
+		if !reflect.DeepEqual(d.Snapshot(), c.Snapshot()) {
+			t.Fatalf("restored machine ended as %+v, want %+v", d.Snapshot(), c.Snapshot())
+		}
+	})
+}
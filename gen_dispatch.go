@@ -0,0 +1,85 @@
+//go:build ignore
+// +build ignore
+
+/*
+   Copyright 2021 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// The gen_dispatch program generates dispatch.go, which has a function for
+// each combination of source and destination.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+)
+
+var (
+	sources = [32]string{
+		"M", "I", "NA", "NB", "A", "SA", "HA", "TA",
+		"LA", "CA", "ZA", "B", "R", "RB", "C", "SC",
+		"RC", "D", "SD", "RD", "Z", "HL", "HU", "S",
+		"PE", "PL", "K", "MA", "MB", "MC", "MD", "PS",
+	}
+	dests = [32]string{
+		"M", "Q", "OT", "OP", "A", "PA", "SA", "CA",
+		"DA", "NA", "P", "B", "XB", "L", "C", "PC",
+		"SC", "D", "PD", "SD", "Z", "HL", "HU", "S",
+		"PS", "CS", "PK", "MA", "MB", "MC", "MD", "T",
+	}
+)
+
+func main() {
+	var b bytes.Buffer
+	fmt.Fprint(&b, `// Code generated by gen_dispatch.go; DO NOT EDIT.
+
+package csirac
+
+// dispatch has a function for each combination of source and destination,
+// indexed by the lower half of the instruction. Each one does the same as
+// ReadSource, then incrementing S and fetching the next instruction into K,
+// then WriteDest, but with the source and destination functions called
+// directly (so they can be inlined) instead of through the tables.
+var dispatch = [1024]func(c *CSIRAC, k Word) error{
+`)
+	for s, sn := range sources {
+		for d, dn := range dests {
+			fmt.Fprintf(&b, "\t%d<<5 | %d: exec%sTo%s,\n", s, d, sn, dn)
+		}
+	}
+	fmt.Fprint(&b, "}\n")
+	for _, sn := range sources {
+		for _, dn := range dests {
+			fmt.Fprintf(&b, `
+func exec%sTo%s(c *CSIRAC, k Word) error {
+	src := src%s(c, k)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return dst%s(c, k, src)
+}
+`, sn, dn, sn, dn)
+		}
+	}
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatalf("Couldn't format generated code: %v", err)
+	}
+	if err := os.WriteFile("dispatch.go", src, 0o644); err != nil {
+		log.Fatalf("Couldn't write dispatch.go: %v", err)
+	}
+}
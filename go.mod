@@ -6,6 +6,7 @@ require github.com/hajimehoshi/ebiten/v2 v2.2.4
 
 require (
 	github.com/go-gl/glfw/v3.3/glfw v0.0.0-20211213063430-748e38ca8aec // indirect
+	github.com/hajimehoshi/oto/v2 v2.1.0-alpha.2 // indirect
 	github.com/jezek/xgb v0.0.0-20210312150743-0e0f116e1240 // indirect
 	golang.org/x/exp v0.0.0-20220124173137-7a6bfc487013 // indirect
 	golang.org/x/image v0.0.0-20211028202545-6944b10bf410 // indirect
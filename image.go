@@ -0,0 +1,54 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package csirac
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// ReadImage reads a memory image (of the main store or a drum). Images are
+// stored as four bytes per word, little-endian, starting from cell 0.
+func ReadImage(r io.Reader) ([]Word, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(b)%4 != 0 {
+		return nil, fmt.Errorf("image length %d is not a multiple of 4", len(b))
+	}
+	m := make([]Word, len(b)/4)
+	for i := range m {
+		w := Word(binary.LittleEndian.Uint32(b[4*i:]))
+		if w&^allBits != 0 {
+			return nil, fmt.Errorf("cell %d: value %#x exceeds 20 bits", i, uint32(w))
+		}
+		m[i] = w
+	}
+	return m, nil
+}
+
+// WriteImage writes a memory image in the format read by ReadImage.
+func WriteImage(w io.Writer, m []Word) error {
+	b := make([]byte, 4*len(m))
+	for i, x := range m {
+		binary.LittleEndian.PutUint32(b[4*i:], uint32(x&allBits))
+	}
+	_, err := w.Write(b)
+	return err
+}
@@ -0,0 +1,48 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package csirac
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestImageRoundTrip(t *testing.T) {
+	m := []Word{0, 1, allBits, signBit, MustParseInstruction("31 27 K  PS")}
+	var buf bytes.Buffer
+	if err := WriteImage(&buf, m); err != nil {
+		t.Fatalf("WriteImage() = %v", err)
+	}
+	if got, want := buf.Len(), 4*len(m); got != want {
+		t.Errorf("WriteImage() wrote %d bytes, want %d", got, want)
+	}
+	got, err := ReadImage(&buf)
+	if err != nil {
+		t.Fatalf("ReadImage() = %v", err)
+	}
+	if !reflect.DeepEqual(got, m) {
+		t.Errorf("ReadImage() = %v, want %v", got, m)
+	}
+
+	if _, err := ReadImage(bytes.NewReader([]byte{0, 0, 0, 0, 1})); err == nil {
+		t.Error("ReadImage(5 bytes) = nil error, want error")
+	}
+	if _, err := ReadImage(bytes.NewReader([]byte{0, 0, 0x10, 0})); err == nil {
+		t.Error("ReadImage(21-bit word) = nil error, want error")
+	}
+}
@@ -0,0 +1,359 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package interprog compiles INTERPROG programs for CSIRAC.
+//
+// INTERPROG was an early high-level language used on CSIRAC in Melbourne.
+// This package implements a subset of it, reconstructed from descriptions of
+// the language rather than the original compiler. Each line holds one
+// statement, optionally preceded by a statement number:
+//
+//	LET v = e              assign e to variable v (LET may be omitted)
+//	READ v                 read a decimal number from the input tape into v
+//	PRINT e                print the value of e on the teleprinter
+//	GO TO n                continue at statement number n
+//	IF e1 rel e2 GO TO n   continue at n if the comparison holds, where rel
+//	                       is one of = <> < > <= >=
+//	STOP                   stop the machine
+//	COMMENT ...            ignored
+//
+// Values are 20-bit signed integers. Variables are named by a letter followed
+// by letters and digits. Expressions are built from variables, integer
+// literals from 0 to 524287, parentheses, unary minus, and the operators +, -
+// and *. Products must fit in 19 bits.
+//
+// On the input tape, a number is a sequence of digits (see
+// csirac.DecimalRow) followed by a row with hole 11 punched. Hole 12 is also
+// punched in that row if the number is negative. Numbers are printed in
+// figures shift, each followed by a carriage return and line feed.
+//
+// Programs are translated into assembler source (see csirac.Assemble), which
+// begins at address 0. The running program uses all the registers.
+package interprog
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/DrJosh9000/CSIRAC"
+	"github.com/DrJosh9000/CSIRAC/stdlib"
+)
+
+// Compile compiles an INTERPROG program into an assembled program.
+func Compile(src io.Reader) (*csirac.Program, error) {
+	asm, err := Translate(src)
+	if err != nil {
+		return nil, err
+	}
+	return csirac.Assemble(strings.NewReader(asm))
+}
+
+// Translate translates an INTERPROG program into assembler source.
+func Translate(src io.Reader) (string, error) {
+	b, err := io.ReadAll(src)
+	if err != nil {
+		return "", err
+	}
+	g := &gen{
+		vars:   make(map[string]bool),
+		consts: make(map[int]bool),
+		stmts:  make(map[int]int),
+		subs:   make(map[string]bool),
+	}
+	for i, line := range strings.Split(string(b), "\n") {
+		if err := g.line(line, i+1); err != nil {
+			return "", fmt.Errorf("line %d: %w", i+1, err)
+		}
+	}
+	for _, ref := range g.refs {
+		if _, ok := g.stmts[ref.num]; !ok {
+			return "", fmt.Errorf("line %d: undefined statement number %d", ref.line, ref.num)
+		}
+	}
+	return g.finish(), nil
+}
+
+type stmtRef struct{ num, line int }
+
+// gen accumulates the assembler source for a program.
+type gen struct {
+	out    strings.Builder
+	vars   map[string]bool
+	consts map[int]bool
+	stmts  map[int]int // statement number -> line
+	refs   []stmtRef
+	subs   map[string]bool // subroutines used
+	labels int
+}
+
+// emit writes one instruction (or directive).
+func (g *gen) emit(format string, args ...interface{}) {
+	fmt.Fprintf(&g.out, "\t"+format+"\n", args...)
+}
+
+func (g *gen) label(l string) { fmt.Fprintf(&g.out, "%s:\n", l) }
+
+func (g *gen) newLabel() string {
+	g.labels++
+	return fmt.Sprintf("r%d", g.labels)
+}
+
+// call calls a library routine (see package stdlib).
+func (g *gen) call(sub string) {
+	g.subs[sub] = true
+	g.out.WriteString(stdlib.Call(sub, g.newLabel()))
+}
+
+// line compiles one line of source.
+func (g *gen) line(text string, num int) error {
+	toks, err := tokenize(text)
+	if err != nil {
+		return err
+	}
+	if len(toks) == 0 || toks[0] == "COMMENT" {
+		return nil
+	}
+	fmt.Fprintf(&g.out, "; %s\n", strings.TrimSpace(text))
+	if n, ok := number(toks[0]); ok {
+		if prev, dup := g.stmts[n]; dup {
+			return fmt.Errorf("statement number %d already used on line %d", n, prev)
+		}
+		g.stmts[n] = num
+		g.label(fmt.Sprintf("L%d", n))
+		toks = toks[1:]
+		if len(toks) == 0 || toks[0] == "COMMENT" {
+			return nil
+		}
+	}
+	p := &parser{toks: toks}
+	switch p.peek() {
+	case "READ":
+		p.next()
+		v, err := p.variable()
+		if err != nil {
+			return err
+		}
+		if err := p.end(); err != nil {
+			return err
+		}
+		g.call("rddec")
+		g.emit("v_%s A M", g.use(v))
+
+	case "PRINT":
+		p.next()
+		e, err := p.expr()
+		if err != nil {
+			return err
+		}
+		if err := p.end(); err != nil {
+			return err
+		}
+		if err := g.expr(e, 0); err != nil {
+			return err
+		}
+		g.emit("15 A D")
+		g.call("prdec")
+		g.call("crlf")
+
+	case "GO", "GOTO":
+		n, err := p.goTo()
+		if err != nil {
+			return err
+		}
+		if err := p.end(); err != nil {
+			return err
+		}
+		g.jump(n, num)
+
+	case "IF":
+		p.next()
+		l, err := p.expr()
+		if err != nil {
+			return err
+		}
+		rel := p.next()
+		r, err := p.expr()
+		if err != nil {
+			return err
+		}
+		n, err := p.goTo()
+		if err != nil {
+			return err
+		}
+		if err := p.end(); err != nil {
+			return err
+		}
+		if err := g.cond(l, rel, r); err != nil {
+			return err
+		}
+		g.jump(n, num)
+
+	case "STOP":
+		p.next()
+		if err := p.end(); err != nil {
+			return err
+		}
+		g.emit("0 PL T")
+
+	default:
+		if p.peek() == "LET" {
+			p.next()
+		}
+		v, err := p.variable()
+		if err != nil {
+			return err
+		}
+		if t := p.next(); t != "=" {
+			return fmt.Errorf("expected = after %s, got %q", v, t)
+		}
+		e, err := p.expr()
+		if err != nil {
+			return err
+		}
+		if err := p.end(); err != nil {
+			return err
+		}
+		if err := g.expr(e, 0); err != nil {
+			return err
+		}
+		g.emit("v_%s A M", g.use(v))
+	}
+	return nil
+}
+
+func (g *gen) jump(n, line int) {
+	g.refs = append(g.refs, stmtRef{num: n, line: line})
+	g.emit("L%d K S", n)
+}
+
+// cond emits code that executes the following instruction only if the
+// comparison holds.
+func (g *gen) cond(l expr, rel string, r expr) error {
+	switch rel {
+	case ">", "<=":
+		l, r = r, l
+	}
+	if err := g.expr(binop{op: '-', l: l, r: r}, 0); err != nil {
+		return err
+	}
+	switch rel {
+	case "<", ">":
+		g.emit("0 SA CS") // if A < 0 { skip next }
+		g.emit("0 PE PS") // skip next
+	case ">=", "<=":
+		g.emit("0 SA CS") // if A < 0 { skip next }
+	case "=":
+		g.emit("0 ZA CS") // if A != 0 { skip next }
+	case "<>":
+		g.emit("0 ZA CS") // if A != 0 { skip next }
+		g.emit("0 PE PS") // skip next
+	default:
+		return fmt.Errorf("invalid comparison %q", rel)
+	}
+	return nil
+}
+
+// maxTemps is the number of D registers (D0 upwards) used to hold
+// intermediate values. The library routines (see package stdlib) use D12
+// upwards.
+const maxTemps = 6
+
+// expr emits code that evaluates e into A, using D registers from temp
+// upwards to hold intermediate values.
+func (g *gen) expr(e expr, temp int) error {
+	if temp >= maxTemps {
+		return fmt.Errorf("expression too complex")
+	}
+	switch e := e.(type) {
+	case num:
+		g.emit("%s M A", g.constant(int(e)))
+	case variable:
+		g.emit("v_%s M A", g.use(string(e)))
+	case neg:
+		if err := g.expr(e.x, temp); err != nil {
+			return err
+		}
+		g.emit("0 TA SA") // A -= 2A
+	case binop:
+		dest := map[byte]string{'+': "PA", '-': "SA"}[e.op]
+		if operand := g.operand(e.r); operand != "" && dest != "" {
+			// Add or subtract directly from the store.
+			if err := g.expr(e.l, temp); err != nil {
+				return err
+			}
+			g.emit("%s M %s", operand, dest)
+			return nil
+		}
+		if err := g.expr(e.r, temp); err != nil {
+			return err
+		}
+		g.emit("%d A D", temp)
+		if err := g.expr(e.l, temp+1); err != nil {
+			return err
+		}
+		if dest != "" {
+			g.emit("%d D %s", temp, dest)
+			return nil
+		}
+		g.emit("15 A D")
+		g.emit("%d D A", temp)
+		g.emit("14 A D")
+		g.call("mul")
+	}
+	return nil
+}
+
+// operand returns the label of the cell holding e, if e is a variable or
+// constant.
+func (g *gen) operand(e expr) string {
+	switch e := e.(type) {
+	case num:
+		return g.constant(int(e))
+	case variable:
+		return "v_" + g.use(string(e))
+	}
+	return ""
+}
+
+func (g *gen) constant(n int) string {
+	g.consts[n] = true
+	return fmt.Sprintf("c_%d", n)
+}
+
+func (g *gen) use(v string) string {
+	g.vars[v] = true
+	return v
+}
+
+// finish returns the complete program.
+func (g *gen) finish() string {
+	g.emit("0 PL T") // in case the program runs off the end
+	for _, sub := range []string{"mul", "rddec", "prdec", "crlf"} {
+		if g.subs[sub] {
+			g.out.WriteString(stdlib.MustSource(sub))
+		}
+	}
+	g.out.WriteString("; data\n")
+	for _, v := range sortedKeys(g.vars) {
+		fmt.Fprintf(&g.out, "v_%s:\t.word 0\n", v)
+	}
+	for _, c := range sortedInts(g.consts) {
+		fmt.Fprintf(&g.out, "c_%d:\t.word %d\n", c, c)
+	}
+	g.emit(".word 0") // Step fetches past the last command
+	return g.out.String()
+}
@@ -0,0 +1,169 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package interprog
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/DrJosh9000/CSIRAC"
+)
+
+// numberTape encodes numbers onto tape in the form READ expects.
+func numberTape(ns ...int) *csirac.InputTape {
+	t := new(csirac.InputTape)
+	for _, n := range ns {
+		end := uint16(1 << 10) // hole 11
+		if n < 0 {
+			end |= 1 << 11 // hole 12
+			n = -n
+		}
+		for _, d := range strconv.Itoa(n) {
+			t.Rows = append(t.Rows, csirac.DecimalRow(int(d-'0')))
+		}
+		t.Rows = append(t.Rows, end)
+	}
+	return t
+}
+
+// run compiles and runs a program, and returns what it printed.
+func run(t *testing.T, src string, input *csirac.InputTape) string {
+	t.Helper()
+	p, err := Compile(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("Compile() = %v", err)
+	}
+	tp := new(csirac.Teleprinter)
+	c := &csirac.CSIRAC{
+		M:     make([]csirac.Word, 1024),
+		Input: input,
+	}
+	c.Attach(tp)
+	copy(c.M, p.Words)
+	c.K = c.M[0]
+	if err := c.Run(0, false); err != nil {
+		t.Fatalf("c.Run(0) = %v, want nil", err)
+	}
+	return tp.String()
+}
+
+func TestFactorials(t *testing.T) {
+	src := `
+		COMMENT PRINT THE FACTORIALS OF 1 TO 8
+		   N = 1
+		   F = 1
+		5  COMMENT THE NEXT FACTORIAL
+		10 F = F * N
+		   PRINT F
+		   LET N = N + 1
+		   IF N <= 8 GO TO 5
+		   STOP
+	`
+	want := "1\n2\n6\n24\n120\n720\n5040\n40320\n"
+	if got := run(t, src, nil); got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
+func TestReadArithmetic(t *testing.T) {
+	src := `
+		READ A
+		READ B
+		PRINT A + B
+		PRINT A - B
+		PRINT A * B
+		PRINT -(A * (B - 3)) + 2 * 7
+		PRINT 0
+	`
+	want := "-98\n-148\n-3075\n2720\n0\n"
+	if got := run(t, src, numberTape(-123, 25)); got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
+func TestComparisons(t *testing.T) {
+	rels := []struct {
+		rel  string
+		want func(a, b int) bool
+	}{
+		{"=", func(a, b int) bool { return a == b }},
+		{"<>", func(a, b int) bool { return a != b }},
+		{"<", func(a, b int) bool { return a < b }},
+		{">", func(a, b int) bool { return a > b }},
+		{"<=", func(a, b int) bool { return a <= b }},
+		{">=", func(a, b int) bool { return a >= b }},
+	}
+	pairs := [][2]int{{3, 5}, {5, 3}, {4, 4}, {-2, 2}, {0, -7}}
+	for _, r := range rels {
+		// The final number, 99, stops the program.
+		src := `
+			1 READ A
+			  IF A = 99 GO TO 3
+			  READ B
+			  IF A ` + r.rel + ` B GO TO 2
+			  PRINT 0
+			  GOTO 1
+			2 PRINT 1
+			  GOTO 1
+			3 STOP
+		`
+		var input []int
+		want := ""
+		for _, p := range pairs {
+			input = append(input, p[0], p[1])
+			if r.want(p[0], p[1]) {
+				want += "1\n"
+			} else {
+				want += "0\n"
+			}
+		}
+		input = append(input, 99)
+		if got := run(t, src, numberTape(input...)); got != want {
+			t.Errorf("A %s B: output = %q, want %q", r.rel, got, want)
+		}
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	tests := []string{
+		"GO TO 10",                        // undefined statement
+		"10 STOP\n10 STOP",                // duplicate statement number
+		"A = ",                            // missing expression
+		"A = (B + C",                      // missing )
+		"PRINT A B",                       // trailing junk
+		"IF A ! B GO TO 1",                // bad character
+		"IF A B GO TO 1\n1",               // missing comparison
+		"READ 5",                          // not a variable
+		"LET = 3",                         // missing variable
+		"X = ((((((1*2)*2)*2)*2)*2)*2)*2", // too complex
+		"PRINT 524288",                    // literal out of range
+	}
+	for _, src := range tests {
+		if _, err := Translate(strings.NewReader(src)); err == nil {
+			t.Errorf("Translate(%q) = nil error, want error", src)
+		}
+	}
+}
+
+func TestLiteralRange(t *testing.T) {
+	src := "PRINT 524287\nPRINT 2000000"
+	_, err := Translate(strings.NewReader(src))
+	if err == nil || !strings.HasPrefix(err.Error(), "line 2: ") {
+		t.Errorf("Translate(%q) = %v, want error on line 2", src, err)
+	}
+}
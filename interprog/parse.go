@@ -0,0 +1,233 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package interprog
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/DrJosh9000/CSIRAC"
+)
+
+// Expression types.
+type (
+	expr     interface{}
+	num      int
+	variable string
+	neg      struct{ x expr }
+	binop    struct {
+		op   byte
+		l, r expr
+	}
+)
+
+var keywords = map[string]bool{
+	"LET": true, "READ": true, "PRINT": true, "GO": true, "GOTO": true,
+	"TO": true, "IF": true, "STOP": true, "COMMENT": true,
+}
+
+// tokenize splits a line into tokens. Letters are upper-cased.
+func tokenize(line string) ([]string, error) {
+	line = strings.ToUpper(line)
+	var toks []string
+	for i := 0; i < len(line); {
+		c := line[i]
+		j := i + 1
+		switch {
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+			continue
+		case '0' <= c && c <= '9':
+			for j < len(line) && '0' <= line[j] && line[j] <= '9' {
+				j++
+			}
+		case 'A' <= c && c <= 'Z':
+			for j < len(line) && ('A' <= line[j] && line[j] <= 'Z' || '0' <= line[j] && line[j] <= '9') {
+				j++
+			}
+			if line[i:j] == "COMMENT" {
+				// The rest of the line is ignored.
+				return append(toks, "COMMENT"), nil
+			}
+		case c == '<':
+			if j < len(line) && (line[j] == '=' || line[j] == '>') {
+				j++
+			}
+		case c == '>':
+			if j < len(line) && line[j] == '=' {
+				j++
+			}
+		case strings.IndexByte("=+-*()", c) >= 0:
+		default:
+			return nil, fmt.Errorf("unexpected character %q", c)
+		}
+		toks = append(toks, line[i:j])
+		i = j
+	}
+	return toks, nil
+}
+
+func number(tok string) (int, bool) {
+	if tok == "" || tok[0] < '0' || tok[0] > '9' {
+		return 0, false
+	}
+	n, err := strconv.Atoi(tok)
+	return n, err == nil
+}
+
+func isVariable(tok string) bool {
+	return tok != "" && 'A' <= tok[0] && tok[0] <= 'Z' && !keywords[tok]
+}
+
+// parser parses the tokens of one statement.
+type parser struct {
+	toks []string
+}
+
+func (p *parser) peek() string {
+	if len(p.toks) == 0 {
+		return ""
+	}
+	return p.toks[0]
+}
+
+func (p *parser) next() string {
+	t := p.peek()
+	if len(p.toks) > 0 {
+		p.toks = p.toks[1:]
+	}
+	return t
+}
+
+func (p *parser) end() error {
+	if len(p.toks) > 0 {
+		return fmt.Errorf("unexpected %q", p.toks[0])
+	}
+	return nil
+}
+
+func (p *parser) variable() (string, error) {
+	t := p.next()
+	if !isVariable(t) {
+		return "", fmt.Errorf("expected variable, got %q", t)
+	}
+	return t, nil
+}
+
+// goTo parses "GO TO n" or "GOTO n".
+func (p *parser) goTo() (int, error) {
+	switch t := p.next(); t {
+	case "GO":
+		if t := p.next(); t != "TO" {
+			return 0, fmt.Errorf("expected TO after GO, got %q", t)
+		}
+	case "GOTO":
+	default:
+		return 0, fmt.Errorf("expected GO TO, got %q", t)
+	}
+	t := p.next()
+	n, ok := number(t)
+	if !ok {
+		return 0, fmt.Errorf("expected statement number, got %q", t)
+	}
+	return n, nil
+}
+
+// expr parses: term {("+"|"-") term}
+func (p *parser) expr() (expr, error) {
+	l, err := p.term()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "+" || p.peek() == "-" {
+		op := p.next()[0]
+		r, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		l = binop{op: op, l: l, r: r}
+	}
+	return l, nil
+}
+
+// term parses: factor {"*" factor}
+func (p *parser) term() (expr, error) {
+	l, err := p.factor()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "*" {
+		p.next()
+		r, err := p.factor()
+		if err != nil {
+			return nil, err
+		}
+		l = binop{op: '*', l: l, r: r}
+	}
+	return l, nil
+}
+
+// factor parses: number | variable | "(" expr ")" | "-" factor
+func (p *parser) factor() (expr, error) {
+	t := p.next()
+	if n, ok := number(t); ok {
+		if n > csirac.MaxInt {
+			return nil, fmt.Errorf("number %s out of valid range [0,%d]", t, csirac.MaxInt)
+		}
+		return num(n), nil
+	}
+	switch {
+	case isVariable(t):
+		return variable(t), nil
+	case t == "-":
+		x, err := p.factor()
+		if err != nil {
+			return nil, err
+		}
+		return neg{x: x}, nil
+	case t == "(":
+		e, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		if t := p.next(); t != ")" {
+			return nil, fmt.Errorf("expected ), got %q", t)
+		}
+		return e, nil
+	}
+	return nil, fmt.Errorf("expected expression, got %q", t)
+}
+
+func sortedKeys(m map[string]bool) []string {
+	ks := make([]string, 0, len(m))
+	for k := range m {
+		ks = append(ks, k)
+	}
+	sort.Strings(ks)
+	return ks
+}
+
+func sortedInts(m map[int]bool) []int {
+	ks := make([]int, 0, len(m))
+	for k := range m {
+		ks = append(ks, k)
+	}
+	sort.Ints(ks)
+	return ks
+}
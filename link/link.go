@@ -0,0 +1,343 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package link combines relocatable objects (see csirac.AssembleObject) into
+// an image that can be loaded into the machine.
+//
+// Modules are placed one after another, in the order given, either in the
+// main store starting at address 0 (so the first module is where the program
+// starts), or on one of the magnetic drums. A module on a drum can't be
+// executed where it is, but its labels can be used with the drum sources (MA
+// to MD) to read data from it. Overlay segments are kept on a drum, and
+// copied into the main store to be executed (see Link).
+package link
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/DrJosh9000/CSIRAC"
+)
+
+// Store identifies the main store or one of the drums.
+type Store int
+
+// The stores that modules can be placed in.
+const (
+	M Store = iota
+	MA
+	MB
+	MC
+	MD
+)
+
+// DrumSize is the number of words on each drum.
+const DrumSize = 1024
+
+func (s Store) String() string {
+	switch s {
+	case M:
+		return "M"
+	case MA:
+		return "MA"
+	case MB:
+		return "MB"
+	case MC:
+		return "MC"
+	case MD:
+		return "MD"
+	}
+	return fmt.Sprintf("Store(%d)", int(s))
+}
+
+// Module is an object to be linked.
+type Module struct {
+	// Name identifies the module in the map and in errors.
+	Name string
+
+	// Object is the assembled object.
+	Object *csirac.Object
+
+	// Store is where to place the module.
+	Store Store
+}
+
+// Config controls how modules are placed.
+type Config struct {
+	// Size is the number of words of main store available. CSIRAC had 1024
+	// words of main store, but often only 768 were working. If zero, 1024 is
+	// used.
+	Size int
+}
+
+// Placement describes where a module was placed.
+type Placement struct {
+	Name  string
+	Store Store
+	Addr  int
+	Size  int
+
+	// Overlay is the name of the overlay segment, if the module is one.
+	// Overlays are placed on a drum (Store and Addr), but run in the overlay
+	// region of M (at Run).
+	Overlay string
+	Run     int
+}
+
+// Symbol describes where an exported label was placed.
+type Symbol struct {
+	Name   string
+	Module string
+	Store  Store
+	Addr   int
+}
+
+// Image is the result of linking.
+type Image struct {
+	// M holds the main store, up to the end of the last module placed in it
+	// (or the end of the overlay region).
+	M []csirac.Word
+
+	// Drums holds the contents of MA to MD, up to the end of the last module
+	// placed on each.
+	Drums [4][]csirac.Word
+
+	// Modules lists where each module was placed: those given, in the order
+	// given, then any overlay loaders.
+	Modules []Placement
+
+	// Symbols lists the exported labels, sorted by name.
+	Symbols []Symbol
+}
+
+// loaderSrc is the source of an overlay loader. It copies the segment from
+// the drum into the overlay region, then jumps to the start of it. It
+// changes A and C.
+const loaderSrc = `
+	.export NAME, NAME_x
+NAME:	0  Z  C      ; C = 0
+NAME_l:	0  C  PK     ; next command += C
+	FROM DRUM A  ; A = next word of the segment
+	0  C  PK     ; next command += C
+	TO A  M      ; store it in the overlay region
+	0  PE PC     ; C++
+	0  C  A      ; A = C
+	SIZE K  SA   ; A -= size of the segment
+	0  ZA CS     ; if A != 0 { skip next }
+	0  PE PS     ; skip next
+	NAME_l K S   ; goto NAME_l
+	TO K  S      ; goto the segment
+NAME_x:	0  Z  Z      ; return
+`
+
+// loader assembles the loader for an overlay segment of size words, stored
+// on drum at address from, that runs at address to.
+func loader(name string, drum Store, from, to, size int) (*csirac.Object, error) {
+	src := strings.NewReplacer(
+		"NAME", name,
+		"DRUM", drum.String(),
+		"FROM", strconv.Itoa(from),
+		"TO", strconv.Itoa(to),
+		"SIZE", strconv.Itoa(size),
+	).Replace(loaderSrc)
+	return csirac.AssembleObject(strings.NewReader(src))
+}
+
+// Link places and relocates the modules.
+//
+// A module that is an overlay segment (see csirac.AssembleObject) must be
+// placed on a drum. All the overlays share one region of the main store,
+// after the other modules, that is large enough for the largest of them. For
+// each overlay the linker generates a loader, placed in the main store
+// before the overlay region and exporting two labels: the overlay's name,
+// and the name with the suffix _x. Jumping to the loader copies the overlay
+// into the region and jumps to the start of it, changing A and C in the
+// process. The _x cell is the loader's return cell, following the stored
+// return jump convention (see package stdlib): the caller stores a jump back
+// to itself there before jumping to the loader, and the overlay returns by
+// jumping to the _x cell.
+func Link(mods []Module, cfg Config) (*Image, error) {
+	size := cfg.Size
+	if size == 0 {
+		size = 1024
+	}
+	if size < 0 || size > 1024 {
+		return nil, fmt.Errorf("main store size %d out of valid range [1,1024]", size)
+	}
+
+	// Place each module.
+	img := new(Image)
+	var next [5]int
+	limit := [5]int{size, DrumSize, DrumSize, DrumSize, DrumSize}
+	place := func(m Module) error {
+		if m.Store < M || m.Store > MD {
+			return fmt.Errorf("module %s: invalid store %v", m.Name, m.Store)
+		}
+		n := len(m.Object.Words)
+		base := next[m.Store]
+		if base+n > limit[m.Store] {
+			return fmt.Errorf("module %s: %d words at %v address %d exceeds %v size %d", m.Name, n, m.Store, base, m.Store, limit[m.Store])
+		}
+		next[m.Store] += n
+		img.Modules = append(img.Modules, Placement{Name: m.Name, Store: m.Store, Addr: base, Size: n, Overlay: m.Object.Overlay, Run: base})
+		return nil
+	}
+	mods = append([]Module(nil), mods...) // loaders are appended
+	var overlays []int
+	region := 0
+	for i, m := range mods {
+		if err := place(m); err != nil {
+			return nil, err
+		}
+		if m.Object.Overlay == "" {
+			continue
+		}
+		if m.Store == M {
+			return nil, fmt.Errorf("module %s: overlay %s must be placed on a drum", m.Name, m.Object.Overlay)
+		}
+		if len(m.Object.Words) == 0 {
+			return nil, fmt.Errorf("module %s: overlay %s is empty", m.Name, m.Object.Overlay)
+		}
+		overlays = append(overlays, i)
+		if n := len(m.Object.Words); n > region {
+			region = n
+		}
+	}
+
+	// Place the loaders, then the overlay region.
+	if len(overlays) > 0 {
+		probe, err := loader("probe", MA, 0, 0, 0)
+		if err != nil {
+			return nil, err
+		}
+		run := next[M] + len(overlays)*len(probe.Words)
+		for _, i := range overlays {
+			p := &img.Modules[i]
+			p.Run = run
+			l, err := loader(p.Overlay, p.Store, p.Addr, run, p.Size)
+			if err != nil {
+				return nil, fmt.Errorf("overlay %s: %w", p.Overlay, err)
+			}
+			mods = append(mods, Module{Name: p.Overlay + " loader", Object: l})
+			if err := place(mods[len(mods)-1]); err != nil {
+				return nil, err
+			}
+		}
+		if run+region > size {
+			return nil, fmt.Errorf("overlay region of %d words at address %d exceeds M size %d", region, run, size)
+		}
+		next[M] = run + region
+	}
+
+	// Find the address of each exported label. Labels in overlays are at
+	// the addresses they run at.
+	symbols := make(map[string]Symbol)
+	for i, m := range mods {
+		p := img.Modules[i]
+		store := p.Store
+		if p.Overlay != "" {
+			store = M
+		}
+		for _, l := range m.Object.Exports {
+			if prev, dup := symbols[l]; dup {
+				return nil, fmt.Errorf("module %s: label %q already exported by module %s", m.Name, l, prev.Module)
+			}
+			symbols[l] = Symbol{Name: l, Module: m.Name, Store: store, Addr: p.Run + m.Object.Labels[l]}
+		}
+	}
+
+	// Relocate each module into its store.
+	img.M = make([]csirac.Word, next[M])
+	for i := range img.Drums {
+		img.Drums[i] = make([]csirac.Word, next[MA+Store(i)])
+	}
+	for i, m := range mods {
+		pl := img.Modules[i]
+		words := append([]csirac.Word(nil), m.Object.Words...)
+		for _, rl := range m.Object.Relocs {
+			delta := pl.Run
+			if rl.Label != "" {
+				sym, ok := symbols[rl.Label]
+				if !ok {
+					return nil, fmt.Errorf("module %s: imported label %q is not exported by any module", m.Name, rl.Label)
+				}
+				delta = sym.Addr
+			}
+			w := words[rl.Addr]
+			switch rl.Field {
+			case csirac.FieldAddr:
+				words[rl.Addr] = (w.Hi()+csirac.Word(delta))%1024<<10 | w.Lo()
+			case csirac.FieldWord:
+				words[rl.Addr] = csirac.IntWord(w.Int() + delta)
+			default:
+				return nil, fmt.Errorf("module %s: invalid relocation field %d", m.Name, rl.Field)
+			}
+		}
+		dst := img.M
+		if m.Store != M {
+			dst = img.Drums[m.Store-MA]
+		}
+		copy(dst[pl.Addr:], words)
+	}
+
+	for _, s := range symbols {
+		img.Symbols = append(img.Symbols, s)
+	}
+	sort.Slice(img.Symbols, func(i, j int) bool { return img.Symbols[i].Name < img.Symbols[j].Name })
+	return img, nil
+}
+
+// Load copies the image into the machine, and prepares it to start at
+// address 0. The machine's stores must be large enough.
+func (img *Image) Load(c *csirac.CSIRAC) error {
+	stores := [5]*[]csirac.Word{&c.M, &c.MA, &c.MB, &c.MC, &c.MD}
+	for i, src := range append([][]csirac.Word{img.M}, img.Drums[:]...) {
+		dst := *stores[i]
+		if len(src) > len(dst) {
+			return fmt.Errorf("image needs %d words of %v, but only %d are present", len(src), Store(i), len(dst))
+		}
+		copy(dst, src)
+	}
+	if len(c.M) == 0 {
+		return fmt.Errorf("machine has no main store")
+	}
+	c.S = 0
+	c.K = c.M[0]
+	return nil
+}
+
+// WriteMap writes a human-readable description of where each module and
+// exported label was placed.
+func (img *Image) WriteMap(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "MODULE\tSTORE\tADDRESS\tSIZE\tOVERLAY")
+	for _, p := range img.Modules {
+		fmt.Fprintf(tw, "%s\t%v\t%d\t%d", p.Name, p.Store, p.Addr, p.Size)
+		if p.Overlay != "" {
+			fmt.Fprintf(tw, "\t%s, runs at M %d", p.Overlay, p.Run)
+		}
+		fmt.Fprintln(tw)
+	}
+	fmt.Fprintln(tw, "\nSYMBOL\tSTORE\tADDRESS\tMODULE")
+	for _, s := range img.Symbols {
+		fmt.Fprintf(tw, "%s\t%v\t%d\t%s\n", s.Name, s.Store, s.Addr, s.Module)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,324 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package link
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/DrJosh9000/CSIRAC"
+	"github.com/DrJosh9000/CSIRAC/stdlib"
+)
+
+func object(t *testing.T, src string) *csirac.Object {
+	t.Helper()
+	o, err := csirac.AssembleObject(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("AssembleObject() = %v\n%s", err, src)
+	}
+	return o
+}
+
+func library(t *testing.T, name string) Module {
+	t.Helper()
+	o, err := stdlib.Object(name)
+	if err != nil {
+		t.Fatalf("stdlib.Object(%q) = %v", name, err)
+	}
+	return Module{Name: name, Object: o}
+}
+
+// main divides each number in a table on drum MA by 7, and prints the
+// quotients, using two library routines.
+const mainSrc = `
+	.import div, div_x, prdec, prdec_x, table, count
+	0  Z  C      ; C = 0
+loop:	0  C  PK     ; next command += C
+	table MA A   ; A = table[C]
+	15 A  D      ; D15 = table[C]
+	7  K  HU     ; D14 = 7
+	0  HL A
+	14 A  D
+	0  C  A      ; save C, since div uses it
+	i  A  M
+	r1 M  A      ; call div
+	div_x A M
+	div K S
+r1:	.+1 K S
+	15 A  D      ; D15 = quotient
+	r2 M  A      ; call prdec
+	prdec_x A M
+	prdec K S
+r2:	.+1 K S
+	i  M  C      ; restore C
+	0  PE PC     ; C++
+	0  C  A      ; A = C
+	count M SA   ; A -= count
+	0  ZA CS     ; if A != 0 { skip next }
+	0  PE PS     ; skip next
+	loop K S     ; goto loop
+	0  PL T      ; stop
+i:	.word 0
+`
+
+// table is placed on drum MA, and read with the MA source. count is placed in
+// M, after the library routines.
+const (
+	tableSrc = `
+	.export table
+table:	.word 700, -21, 5000
+`
+	countSrc = `
+	.export count
+count:	.word (0, 3, 0, 0) ; 3 in the upper half, like C
+	.word 0            ; Step fetches past the stop
+`
+)
+
+func TestLinkAndRun(t *testing.T) {
+	mods := []Module{
+		{Name: "main", Object: object(t, mainSrc)},
+		library(t, "div"),
+		library(t, "prdec"),
+		{Name: "count", Object: object(t, countSrc)},
+		{Name: "table", Object: object(t, tableSrc), Store: MA},
+	}
+	img, err := Link(mods, Config{Size: 768})
+	if err != nil {
+		t.Fatalf("Link() = %v", err)
+	}
+	tp := new(csirac.Teleprinter)
+	c := &csirac.CSIRAC{
+		M:  make([]csirac.Word, 768),
+		MA: make([]csirac.Word, DrumSize),
+	}
+	c.Attach(tp)
+	if err := img.Load(c); err != nil {
+		t.Fatalf("img.Load() = %v", err)
+	}
+	if err := c.Run(0, false); err != nil {
+		t.Fatalf("c.Run(0) = %v, want nil", err)
+	}
+	if got, want := tp.String(), "100-3714"; got != want {
+		t.Errorf("printed %q, want %q", got, want)
+	}
+
+	var sb strings.Builder
+	if err := img.WriteMap(&sb); err != nil {
+		t.Fatalf("img.WriteMap() = %v", err)
+	}
+	for _, want := range []string{"table   MA     0        3", "count    M      147"} {
+		if !strings.Contains(sb.String(), want) {
+			t.Errorf("map doesn't contain %q:\n%s", want, sb.String())
+		}
+	}
+}
+
+func TestLinkErrors(t *testing.T) {
+	big := object(t, ".org 499\n.word 0")
+	tests := []struct {
+		name string
+		mods []Module
+	}{
+		{
+			name: "too big for 768 words",
+			mods: []Module{{Name: "a", Object: big}, {Name: "b", Object: big}},
+		},
+		{
+			name: "too big for drum",
+			mods: []Module{{Name: "a", Object: big, Store: MB}, {Name: "b", Object: big, Store: MB}, {Name: "c", Object: big, Store: MB}},
+		},
+		{
+			name: "missing import",
+			mods: []Module{{Name: "a", Object: object(t, ".import x\nx K S")}},
+		},
+		{
+			name: "duplicate export",
+			mods: []Module{
+				{Name: "a", Object: object(t, ".export x\nx: .word 0")},
+				{Name: "b", Object: object(t, ".export x\nx: .word 0")},
+			},
+		},
+		{
+			name: "invalid store",
+			mods: []Module{{Name: "a", Object: big, Store: 7}},
+		},
+	}
+	for _, test := range tests {
+		if _, err := Link(test.mods, Config{Size: 768}); err == nil {
+			t.Errorf("%s: Link() = nil error, want error", test.name)
+		}
+	}
+}
+
+func TestLinkRelocations(t *testing.T) {
+	a := object(t, `
+	.import b
+x:	x  K  S      ; relative
+	b+1 M A      ; imported, plus a constant
+	.word x, b, x-., 5
+`)
+	img, err := Link([]Module{
+		{Name: "pad", Object: object(t, ".org 9\n.word 0")},
+		{Name: "a", Object: a},
+		{Name: "b", Object: object(t, ".export b\n.word 0\nb: .word 0"), Store: MC},
+	}, Config{})
+	if err != nil {
+		t.Fatalf("Link() = %v", err)
+	}
+	want := []csirac.Word{
+		csirac.MustParseInstruction("0 10 K S"),
+		csirac.MustParseInstruction("0 2 M A"),
+		10, 1, csirac.IntWord(-4), 5,
+	}
+	for i, w := range want {
+		if got := img.M[10+i]; got != w {
+			t.Errorf("img.M[%d] = %v, want %v", 10+i, got, w)
+		}
+	}
+}
+
+// main calls overlay one, then two, then one again.
+const overlayMainSrc = `
+	.import one, one_x, two, two_x
+r1:	r1+3 M A     ; call one
+	one_x A M
+	one K S
+	.+1 K S
+r2:	r2+3 M A     ; call two
+	two_x A M
+	two K S
+	.+1 K S
+r3:	r3+3 M A     ; call one
+	one_x A M
+	one K S
+	.+1 K S
+	0  PL T      ; stop
+	.word 0
+`
+
+// Each overlay counts how many times it has run, and prints the count
+// followed by its own number. Since an overlay is copied from the drum each
+// time it is loaded, the count should always be 1.
+const overlaySrc = `
+	.overlay NAME
+	.import NAME_x, prdec, prdec_x
+	count M A    ; count++
+	0  PL PA
+	count A M
+	15 A  D      ; print count
+	r1 M  A
+	prdec_x A M
+	prdec K S
+r1:	.+1 K S
+	NUM K HU     ; print NUM
+	0  HL A
+	15 A  D
+	r2 M  A
+	prdec_x A M
+	prdec K S
+r2:	.+1 K S
+	NAME_x K S   ; return
+count:	.word 0
+`
+
+func TestOverlays(t *testing.T) {
+	ov := func(name, num string) *csirac.Object {
+		return object(t, strings.NewReplacer("NAME", name, "NUM", num).Replace(overlaySrc))
+	}
+	mods := []Module{
+		{Name: "main", Object: object(t, overlayMainSrc)},
+		library(t, "prdec"),
+		{Name: "one", Object: ov("one", "1"), Store: MA},
+		{Name: "two", Object: ov("two", "22"), Store: MB},
+	}
+	img, err := Link(mods, Config{Size: 768})
+	if err != nil {
+		t.Fatalf("Link() = %v", err)
+	}
+	if got, want := len(img.Modules), 6; got != want {
+		t.Fatalf("len(img.Modules) = %d, want %d", got, want)
+	}
+	one, two := img.Modules[2], img.Modules[3]
+	if one.Run != two.Run || one.Store != MA || two.Store != MB {
+		t.Errorf("overlays placed at %+v and %+v, want same Run, stored in MA and MB", one, two)
+	}
+	if got, want := len(img.M), one.Run+one.Size; got != want {
+		t.Errorf("len(img.M) = %d, want %d", got, want)
+	}
+
+	tp := new(csirac.Teleprinter)
+	c := &csirac.CSIRAC{
+		M:  make([]csirac.Word, 768),
+		MA: make([]csirac.Word, DrumSize),
+		MB: make([]csirac.Word, DrumSize),
+	}
+	c.Attach(tp)
+	if err := img.Load(c); err != nil {
+		t.Fatalf("img.Load() = %v", err)
+	}
+	// Nothing is in the overlay region until an overlay is loaded.
+	for a := one.Run; a < one.Run+one.Size; a++ {
+		if c.M[a] != 0 {
+			t.Fatalf("before Run: c.M[%d] = %v, want 0", a, c.M[a])
+		}
+	}
+	if err := c.Run(0, false); err != nil {
+		t.Fatalf("c.Run(0) = %v, want nil", err)
+	}
+	if got, want := tp.String(), "1112211"; got != want {
+		t.Errorf("printed %q, want %q", got, want)
+	}
+	// Overlay one is in the region, with its count incremented once.
+	for i, w := range img.Drums[0][:one.Size-1] {
+		if got := c.M[one.Run+i]; got != w {
+			t.Errorf("after Run: c.M[%d] = %v, want %v", one.Run+i, got, w)
+		}
+	}
+	if got, want := c.M[one.Run+one.Size-1], csirac.Word(1); got != want {
+		t.Errorf("after Run: count = %v, want %v", got, want)
+	}
+}
+
+func TestOverlayErrors(t *testing.T) {
+	ov := object(t, ".overlay x\n.import x_x\nx_x K S")
+	tests := []struct {
+		name string
+		mods []Module
+	}{
+		{
+			name: "overlay in M",
+			mods: []Module{{Name: "x", Object: ov}},
+		},
+		{
+			name: "region too big",
+			mods: []Module{
+				{Name: "a", Object: object(t, ".org 760\n.word 0")},
+				{Name: "x", Object: ov, Store: MA},
+			},
+		},
+		{
+			name: "empty overlay",
+			mods: []Module{{Name: "x", Object: object(t, ".overlay x"), Store: MA}},
+		},
+	}
+	for _, test := range tests {
+		if _, err := Link(test.mods, Config{Size: 768}); err == nil {
+			t.Errorf("%s: Link() = nil error, want error", test.name)
+		}
+	}
+}
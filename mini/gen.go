@@ -0,0 +1,559 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package mini
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/DrJosh9000/CSIRAC"
+	"github.com/DrJosh9000/CSIRAC/stdlib"
+)
+
+// localRegs is the number of D registers (D0 upwards) available for
+// parameters and locals. The runtime routines use the rest.
+const localRegs = 12
+
+// gen generates assembler source for a program.
+type gen struct {
+	out     strings.Builder
+	globals map[string]*global
+	funcs   map[string]*function
+	fn      *function // function being generated
+	consts  map[csirac.Word]bool
+	temps   map[string]int // function -> number of temporary cells
+	subs    map[string]bool
+	labels  int
+}
+
+func (g *gen) emit(format string, args ...interface{}) {
+	fmt.Fprintf(&g.out, "\t"+format+"\n", args...)
+}
+
+func (g *gen) label(l string) { fmt.Fprintf(&g.out, "%s:\n", l) }
+
+func (g *gen) newLabel() string {
+	g.labels++
+	return fmt.Sprintf("l%d", g.labels)
+}
+
+// call calls a subroutine (f_name for functions), with a stored return jump
+// (see stdlib.Call).
+func (g *gen) call(sub string) {
+	g.out.WriteString(stdlib.Call(sub, g.newLabel()))
+}
+
+// runtime calls a runtime routine: a library routine (see package stdlib),
+// or one in runtime.
+func (g *gen) runtime(sub string) {
+	g.subs[sub] = true
+	g.call(sub)
+}
+
+// temp returns the label of temporary cell n of the current function.
+func (g *gen) temp(n int) string {
+	if n >= g.temps[g.fn.name] {
+		g.temps[g.fn.name] = n + 1
+	}
+	return fmt.Sprintf("t_%s_%d", g.fn.name, n)
+}
+
+func (g *gen) constant(w csirac.Word) string {
+	g.consts[w] = true
+	return fmt.Sprintf("k_%d", w)
+}
+
+func (g *gen) lookup(name string) (*local, *global) {
+	for _, l := range g.fn.locals {
+		if l.name == name {
+			return l, nil
+		}
+	}
+	return nil, g.globals[name]
+}
+
+// generate generates the whole program.
+func generate(prog *program) (string, error) {
+	g := &gen{
+		globals: make(map[string]*global),
+		funcs:   make(map[string]*function),
+		consts:  make(map[csirac.Word]bool),
+		temps:   make(map[string]int),
+		subs:    make(map[string]bool),
+	}
+	for _, gl := range prog.globals {
+		if _, dup := g.globals[gl.name]; dup {
+			return "", fmt.Errorf("line %d: %s already declared", gl.line, gl.name)
+		}
+		g.globals[gl.name] = gl
+	}
+	for _, f := range prog.funcs {
+		if _, dup := g.funcs[f.name]; dup {
+			return "", fmt.Errorf("line %d: function %s already declared", f.line, f.name)
+		}
+		g.funcs[f.name] = f
+	}
+	main := g.funcs["main"]
+	if main == nil {
+		return "", fmt.Errorf("no main function")
+	}
+	if len(main.params) > 0 {
+		return "", fmt.Errorf("line %d: main must not have parameters", main.line)
+	}
+	if err := g.allocate(prog); err != nil {
+		return "", err
+	}
+
+	g.out.WriteString("; start\n")
+	g.call("f_main")
+	g.emit("0 PL T")
+	for _, f := range prog.funcs {
+		if err := g.function(f); err != nil {
+			return "", err
+		}
+	}
+	for _, sub := range []string{"mul", "mulf", "prdec", "prf", "crlf"} {
+		if !g.subs[sub] {
+			continue
+		}
+		if src, ok := runtime[sub]; ok {
+			g.out.WriteString(src)
+			continue
+		}
+		g.out.WriteString(stdlib.MustSource(sub))
+	}
+
+	g.out.WriteString("; data\n")
+	for _, gl := range prog.globals {
+		if gl.size > 0 {
+			fmt.Fprintf(&g.out, "g_%s:\t.org .+%d\n", gl.name, gl.size)
+			continue
+		}
+		fmt.Fprintf(&g.out, "g_%s:\t.word %d\n", gl.name, gl.init)
+	}
+	consts := make([]int, 0, len(g.consts))
+	for w := range g.consts {
+		consts = append(consts, int(w))
+	}
+	sort.Ints(consts)
+	for _, w := range consts {
+		fmt.Fprintf(&g.out, "k_%d:\t.word %d\n", w, w)
+	}
+	for _, f := range prog.funcs {
+		for i := 0; i < g.temps[f.name]; i++ {
+			fmt.Fprintf(&g.out, "t_%s_%d:\t.word 0\n", f.name, i)
+		}
+	}
+	g.emit(".word 0") // Step fetches past the last command
+	return g.out.String(), nil
+}
+
+// allocate assigns D registers to the locals of every function. Since
+// functions can't be recursive, a function only needs registers distinct from
+// those of the functions that might be active when it is called.
+func (g *gen) allocate(prog *program) error {
+	// Check calls, and order functions so that callers come before callees.
+	var order []*function
+	state := make(map[string]int) // 1 = visiting, 2 = done
+	var visit func(f *function) error
+	visit = func(f *function) error {
+		switch state[f.name] {
+		case 1:
+			return fmt.Errorf("line %d: %s is recursive, which is not supported", f.line, f.name)
+		case 2:
+			return nil
+		}
+		state[f.name] = 1
+		for callee, line := range f.calls {
+			cf := g.funcs[callee]
+			if cf == nil {
+				return fmt.Errorf("line %d: undefined function %s", line, callee)
+			}
+			if err := visit(cf); err != nil {
+				return err
+			}
+		}
+		state[f.name] = 2
+		order = append(order, f)
+		return nil
+	}
+	for _, f := range prog.funcs {
+		if err := visit(f); err != nil {
+			return err
+		}
+	}
+
+	base := make(map[string]int)
+	for i := len(order) - 1; i >= 0; i-- {
+		f := order[i]
+		b := base[f.name]
+		if b+len(f.locals) > localRegs {
+			return fmt.Errorf("line %d: out of D registers for the locals of %s", f.line, f.name)
+		}
+		for j, l := range f.locals {
+			l.reg = b + j
+		}
+		for callee := range f.calls {
+			if n := b + len(f.locals); n > base[callee] {
+				base[callee] = n
+			}
+		}
+	}
+	return nil
+}
+
+func (g *gen) function(f *function) error {
+	g.fn = f
+	fmt.Fprintf(&g.out, "; func %s\n", f.name)
+	g.label("f_" + f.name)
+	if err := g.block(f.body); err != nil {
+		return err
+	}
+	g.label(fmt.Sprintf("f_%s_x", f.name))
+	g.emit("0 Z Z") // return
+	return nil
+}
+
+func (g *gen) block(body []stmt) error {
+	for _, s := range body {
+		if err := g.statement(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *gen) statement(s stmt) error {
+	switch s := s.(type) {
+	case *assignStmt:
+		if err := g.assign(s); err != nil {
+			return fmt.Errorf("line %d: %w", s.line, err)
+		}
+
+	case *ifStmt:
+		els, end := g.newLabel(), g.newLabel()
+		if err := g.cond(s.cond, els); err != nil {
+			return fmt.Errorf("line %d: %w", s.line, err)
+		}
+		if err := g.block(s.then); err != nil {
+			return err
+		}
+		if s.els != nil {
+			g.emit("%s K S", end)
+		}
+		g.label(els)
+		if err := g.block(s.els); err != nil {
+			return err
+		}
+		g.label(end)
+
+	case *whileStmt:
+		top, end := g.newLabel(), g.newLabel()
+		g.label(top)
+		if err := g.cond(s.cond, end); err != nil {
+			return fmt.Errorf("line %d: %w", s.line, err)
+		}
+		if err := g.block(s.body); err != nil {
+			return err
+		}
+		g.emit("%s K S", top)
+		g.label(end)
+
+	case *returnStmt:
+		if (s.value == nil) != (g.fn.ret == tVoid) {
+			return fmt.Errorf("line %d: %s returns %v", s.line, g.fn.name, g.fn.ret)
+		}
+		if s.value != nil {
+			t, err := g.expr(s.value, 0)
+			if err != nil {
+				return fmt.Errorf("line %d: %w", s.line, err)
+			}
+			if t != g.fn.ret {
+				return fmt.Errorf("line %d: returning %v from %s, which returns %v", s.line, t, g.fn.name, g.fn.ret)
+			}
+		}
+		g.emit("f_%s_x K S", g.fn.name)
+
+	case *printStmt:
+		t, err := g.expr(s.value, 0)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", s.line, err)
+		}
+		g.emit("15 A D")
+		if t == tFrac {
+			g.runtime("prf")
+		} else {
+			g.runtime("prdec")
+		}
+		g.runtime("crlf")
+
+	case *exprStmt:
+		if _, err := g.callFunc(s.call, 0, true); err != nil {
+			return fmt.Errorf("line %d: %w", s.line, err)
+		}
+	}
+	return nil
+}
+
+func (g *gen) assign(s *assignStmt) error {
+	switch t := s.target.(type) {
+	case *ident:
+		l, gl := g.lookup(t.name)
+		vt, err := g.expr(s.value, 0)
+		if err != nil {
+			return err
+		}
+		switch {
+		case l != nil:
+			if vt != l.typ {
+				return fmt.Errorf("assigning %v to %s, which is %v", vt, t.name, l.typ)
+			}
+			g.emit("%d A D", l.reg)
+		case gl != nil && gl.size == 0:
+			if vt != gl.typ {
+				return fmt.Errorf("assigning %v to %s, which is %v", vt, t.name, gl.typ)
+			}
+			g.emit("g_%s A M", t.name)
+		default:
+			return fmt.Errorf("%s is not a variable", t.name)
+		}
+
+	case *index:
+		_, gl := g.lookup(t.name)
+		if gl == nil || gl.size == 0 {
+			return fmt.Errorf("%s is not an array", t.name)
+		}
+		vt, err := g.expr(s.value, 0)
+		if err != nil {
+			return err
+		}
+		if vt != gl.typ {
+			return fmt.Errorf("assigning %v to an element of %s, which is %v", vt, t.name, gl.typ)
+		}
+		tmp := g.temp(0)
+		g.emit("%s A M", tmp)
+		if err := g.subscript(t, 1); err != nil {
+			return err
+		}
+		g.emit("%s M A", tmp)
+		g.emit("0 HU PK") // next command += index
+		g.emit("g_%s A M", t.name)
+	}
+	return nil
+}
+
+// subscript evaluates the index of an array element into H.
+func (g *gen) subscript(e *index, depth int) error {
+	it, err := g.expr(e.idx, depth)
+	if err != nil {
+		return err
+	}
+	if it != tInt {
+		return fmt.Errorf("index of %s is %v, want int", e.name, it)
+	}
+	g.emit("0 A HL")
+	return nil
+}
+
+// cond emits code that jumps to label unless e holds.
+func (g *gen) cond(e expr, label string) error {
+	b, ok := e.(*binary)
+	if !ok || !isComparison(b.op) {
+		if _, err := g.expr(e, 0); err != nil {
+			return err
+		}
+		g.emit("0 ZA CS") // if A != 0 { skip next }
+		g.emit("%s K S", label)
+		return nil
+	}
+	l, r := b.l, b.r
+	if b.op == ">" || b.op == "<=" {
+		l, r = r, l
+	}
+	if _, err := g.expr(&binary{op: "-", l: l, r: r}, 0); err != nil {
+		return err
+	}
+	switch b.op {
+	case "<", ">":
+		g.emit("0 SA CS") // if A < 0 { skip next }
+	case ">=", "<=":
+		g.emit("0 SA CS") // if A < 0 { skip next }
+		g.emit("0 PE PS") // skip next
+	case "==":
+		g.emit("0 ZA CS") // if A != 0 { skip next }
+		g.emit("0 PE PS") // skip next
+	case "!=":
+		g.emit("0 ZA CS") // if A != 0 { skip next }
+	}
+	g.emit("%s K S", label)
+	return nil
+}
+
+func isComparison(op string) bool {
+	switch op {
+	case "==", "!=", "<", "<=", ">", ">=":
+		return true
+	}
+	return false
+}
+
+// operand returns the source of a simple operand (a literal or variable)
+// that can be used directly as the source of an instruction, such as
+// "k_5 M" or "3 D".
+func (g *gen) operand(e expr) (string, typ) {
+	switch e := e.(type) {
+	case *intLit, *fracLit:
+		w, t, _ := constValue(e)
+		return g.constant(w) + " M", t
+	case *ident:
+		l, gl := g.lookup(e.name)
+		switch {
+		case l != nil:
+			return fmt.Sprintf("%d D", l.reg), l.typ
+		case gl != nil && gl.size == 0:
+			return fmt.Sprintf("g_%s M", e.name), gl.typ
+		}
+	}
+	return "", tVoid
+}
+
+// expr emits code that evaluates e into A, using temporary cells from depth
+// upwards. It returns the type of e.
+func (g *gen) expr(e expr, depth int) (typ, error) {
+	switch e := e.(type) {
+	case *intLit, *fracLit:
+		src, t := g.operand(e)
+		g.emit("%s A", src)
+		return t, nil
+
+	case *ident:
+		src, t := g.operand(e)
+		if src == "" {
+			return tVoid, fmt.Errorf("%s is not a variable", e.name)
+		}
+		g.emit("%s A", src)
+		return t, nil
+
+	case *index:
+		_, gl := g.lookup(e.name)
+		if gl == nil || gl.size == 0 {
+			return tVoid, fmt.Errorf("%s is not an array", e.name)
+		}
+		if err := g.subscript(e, depth); err != nil {
+			return tVoid, err
+		}
+		g.emit("0 HU PK") // next command += index
+		g.emit("g_%s M A", e.name)
+		return gl.typ, nil
+
+	case *unary:
+		t, err := g.expr(e.x, depth)
+		if err != nil {
+			return tVoid, err
+		}
+		g.emit("0 TA SA") // A -= 2A
+		return t, nil
+
+	case *call:
+		return g.callFunc(e, depth, false)
+
+	case *binary:
+		if isComparison(e.op) {
+			return tVoid, fmt.Errorf("comparisons are only allowed in conditions")
+		}
+		dest := map[string]string{"+": "PA", "-": "SA"}[e.op]
+		if src, rt := g.operand(e.r); src != "" && dest != "" {
+			// Add or subtract directly from the operand.
+			lt, err := g.expr(e.l, depth)
+			if err != nil {
+				return tVoid, err
+			}
+			if lt != rt {
+				return tVoid, fmt.Errorf("mismatched types %v %s %v", lt, e.op, rt)
+			}
+			g.emit("%s %s", src, dest)
+			return lt, nil
+		}
+		rt, err := g.expr(e.r, depth)
+		if err != nil {
+			return tVoid, err
+		}
+		tmp := g.temp(depth)
+		g.emit("%s A M", tmp)
+		lt, err := g.expr(e.l, depth+1)
+		if err != nil {
+			return tVoid, err
+		}
+		if dest != "" {
+			if lt != rt {
+				return tVoid, fmt.Errorf("mismatched types %v %s %v", lt, e.op, rt)
+			}
+			g.emit("%s M %s", tmp, dest)
+			return lt, nil
+		}
+		// Multiply using XB. With two integers the product is in the
+		// lower part; with a fraction involved it is in the upper part.
+		g.emit("15 A D")
+		g.emit("%s M A", tmp)
+		g.emit("14 A D")
+		switch {
+		case lt == tInt && rt == tInt:
+			g.runtime("mul")
+			return tInt, nil
+		case lt == tFrac && rt == tFrac:
+			g.runtime("mulf")
+			return tFrac, nil
+		default:
+			g.runtime("mulf")
+			return tInt, nil
+		}
+	}
+	return tVoid, fmt.Errorf("unsupported expression %T", e)
+}
+
+// callFunc calls a function, leaving its result in A.
+func (g *gen) callFunc(c *call, depth int, void bool) (typ, error) {
+	f := g.funcs[c.name]
+	if f == nil {
+		return tVoid, fmt.Errorf("undefined function %s", c.name)
+	}
+	if f.ret == tVoid && !void {
+		return tVoid, fmt.Errorf("%s doesn't return a value", c.name)
+	}
+	if len(c.args) != len(f.params) {
+		return tVoid, fmt.Errorf("%s takes %d arguments, got %d", c.name, len(f.params), len(c.args))
+	}
+	// Evaluate all the arguments before loading any parameter registers,
+	// since evaluating an argument can call other functions.
+	for i, a := range c.args {
+		t, err := g.expr(a, depth+i)
+		if err != nil {
+			return tVoid, err
+		}
+		if p := f.params[i]; t != p.typ {
+			return tVoid, fmt.Errorf("argument %d of %s is %v, want %v", i+1, c.name, t, p.typ)
+		}
+		g.emit("%s A M", g.temp(depth+i))
+	}
+	for i, p := range f.params {
+		g.emit("%s M A", g.temp(depth+i))
+		g.emit("%d A D", p.reg)
+	}
+	g.call("f_" + c.name)
+	return f.ret, nil
+}
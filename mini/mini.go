@@ -0,0 +1,96 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package mini compiles Mini, a tiny structured language for teaching, into
+// CSIRAC assembler source.
+//
+// A Mini program is a list of global variables and functions:
+//
+//	int total;              // a global integer
+//	frac half = 0.5;        // a global fraction, with an initial value
+//	int data[10];           // a global array
+//
+//	func sum(int n) int {   // a function with a parameter and a result
+//		int i = 0;          // locals are declared inside functions
+//		int s = 0;
+//		while (i < n) {
+//			s = s + data[i];
+//			i = i + 1;
+//		}
+//		return s;
+//	}
+//
+//	func main() {           // the program starts by calling main
+//		data[0] = 3;
+//		data[1] = 4;
+//		print(sum(2) * 6);  // prints 42
+//	}
+//
+// There are two types: int (20-bit signed integers) and frac (signed 19-bit
+// fractions between -1 and 1). Statements are assignments, if (with optional
+// else), while, return, print, and function calls. Expressions are built from
+// literals, variables, array elements, function calls, parentheses, unary
+// minus, + and - (between values of the same type), and *. Conditions compare
+// two values with ==, !=, <, <=, > or >=, or test a value for being non-zero.
+//
+// Multiplication uses the XB destination. The product of two ints is an int,
+// of two fracs is a frac, and of an int and a frac is an int (truncated).
+// Products of ints must fit in 19 bits.
+//
+// The generated code shows off a few features of CSIRAC:
+//
+//   - Parameters and locals live in D registers D0-D11. Since functions are
+//     not reentrant, a function's locals only need to avoid the registers of
+//     functions that could be active at the same time.
+//   - Functions are called with stored return jumps: the caller writes a jump
+//     back to itself into the last cell of the function, then jumps to it.
+//     Recursion is therefore not supported.
+//   - Arrays are indexed by adding the index to the next command with PK.
+//   - Globals, constants and temporary values live in the main store.
+//
+// Compiled programs start at address 0, stop after main returns, and use all
+// the registers. print writes to the teleprinter in figures shift.
+package mini
+
+import (
+	"io"
+	"strings"
+
+	"github.com/DrJosh9000/CSIRAC"
+)
+
+// Compile compiles a Mini program into an assembled program.
+func Compile(src io.Reader) (*csirac.Program, error) {
+	asm, err := Translate(src)
+	if err != nil {
+		return nil, err
+	}
+	return csirac.Assemble(strings.NewReader(asm))
+}
+
+// Translate translates a Mini program into assembler source, which can be
+// assembled by csirac.Assemble or csirac.ParseProgram.
+func Translate(src io.Reader) (string, error) {
+	b, err := io.ReadAll(src)
+	if err != nil {
+		return "", err
+	}
+	prog, err := parse(string(b))
+	if err != nil {
+		return "", err
+	}
+	return generate(prog)
+}
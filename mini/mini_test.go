@@ -0,0 +1,209 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package mini
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/DrJosh9000/CSIRAC"
+)
+
+// run compiles and runs a program, and returns what it printed.
+func run(t *testing.T, src string) string {
+	t.Helper()
+	asm, err := Translate(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("Translate() = %v", err)
+	}
+	program, err := csirac.ParseProgram(strings.NewReader(asm))
+	if err != nil {
+		t.Fatalf("ParseProgram(Translate()) = %v\n%s", err, asm)
+	}
+	tp := new(csirac.Teleprinter)
+	c := &csirac.CSIRAC{
+		M: make([]csirac.Word, 1024),
+	}
+	c.Attach(tp)
+	copy(c.M, program)
+	c.K = c.M[0]
+	if err := c.Run(0, false); err != nil {
+		t.Fatalf("c.Run(0) = %v, want nil", err)
+	}
+	return tp.String()
+}
+
+func TestPrograms(t *testing.T) {
+	tests := []struct {
+		name, src, want string
+	}{
+		{
+			name: "package example",
+			src: `
+				int total;
+				frac half = 0.5;
+				int data[10];
+
+				func sum(int n) int {
+					int i = 0;
+					int s = 0;
+					while (i < n) {
+						s = s + data[i];
+						i = i + 1;
+					}
+					return s;
+				}
+
+				func main() {
+					data[0] = 3;
+					data[1] = 4;
+					print(sum(2) * 6);
+				}
+			`,
+			want: "42\n",
+		},
+		{
+			name: "fractions",
+			src: `
+				frac half = 0.5;
+				func main() {
+					frac q = half * half;
+					print(q);
+					print(q - 0.75);
+					print(-0.125 * half);
+					print(1000 * q);
+					print(-1000 * 0.1);
+					print(-0.5 - 0.5);
+				}
+			`,
+			want: "0.25000\n-0.50000\n-0.06250\n250\n-100\n-1.00000\n",
+		},
+		{
+			name: "if else",
+			src: `
+				func sign(int x) int {
+					if (x < 0) {
+						return -1;
+					} else if (x == 0) {
+						return 0;
+					}
+					return 1;
+				}
+				func main() {
+					print(sign(-7));
+					print(sign(0));
+					print(sign(12345));
+					if (sign(3) != 1) { print(99); }
+					if (sign(3) - 1) { print(98); }
+					if (2 >= 2) { print(2); }
+					if (2 <= 1) { print(97); } else { print(3); }
+					if (1 > 2) { print(96); }
+				}
+			`,
+			want: "-1\n0\n1\n2\n3\n",
+		},
+		{
+			name: "nested calls share registers",
+			src: `
+				func sq(int x) int { return x * x; }
+				func add(int a, int b) int { return a + b; }
+				func hyp(int a, int b) int {
+					int t = add(sq(a), sq(b));
+					return t;
+				}
+				func main() {
+					print(hyp(3, 4));
+					print(add(sq(5), add(sq(-12), 0)));
+					print(-3 * 7);
+					print(-3 * -7);
+				}
+			`,
+			want: "25\n169\n-21\n21\n",
+		},
+		{
+			name: "bubble sort",
+			src: `
+				int a[6];
+				func sort(int n) {
+					int i = 0;
+					while (i < n) {
+						int j = 0;
+						while (j < n - i - 1) {
+							if (a[j] > a[j + 1]) {
+								int t = a[j];
+								a[j] = a[j + 1];
+								a[j + 1] = t;
+							}
+							j = j + 1;
+						}
+						i = i + 1;
+					}
+				}
+				func main() {
+					a[0] = 31; a[1] = -4; a[2] = 15; a[3] = 9; a[4] = 0; a[5] = 26;
+					sort(6);
+					int i = 0;
+					while (i < 6) {
+						print(a[i]);
+						i = i + 1;
+					}
+				}
+			`,
+			want: "-4\n0\n9\n15\n26\n31\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := run(t, test.src); got != test.want {
+				t.Errorf("output = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	tests := []string{
+		`func f() {}`, // no main
+		`func main(int x) {}`,
+		`func main() { f(); }`,
+		`func f() { f(); } func main() { f(); }`,
+		`func f(int x) int { return x; } func main() { print(f(0.5)); }`,
+		`func f(int x) int { return x; } func main() { print(f()); }`,
+		`func f() { } func main() { print(f()); }`,
+		`func main() { int x = 0.5; }`,
+		`func main() { int x; int x; }`,
+		`func main() { print(1 + 0.5); }`,
+		`func main() { print(x); }`,
+		`func main() { print(1 < 2); }`,
+		`int a[3]; func main() { a = 1; }`,
+		`int a[3]; func main() { a[0.5] = 1; }`,
+		`int x; func main() { x[0] = 1; }`,
+		`func main() int { return; }`,
+		`func main() { return 1; }`,
+		`func main() { print(1.5); }`,
+		`func main() { print(1) }`,
+		`func main() { print(1 ! 2); }`,
+		`int x = y; func main() {}`,
+		`int x; int x; func main() {}`,
+		`func main() { int a; int b; int c; int d; int e; int f; int g; int h; int i; int j; int k; int l; int m; }`,
+	}
+	for _, src := range tests {
+		if _, err := Translate(strings.NewReader(src)); err == nil {
+			t.Errorf("Translate(%q) = nil error, want error", src)
+		}
+	}
+}
@@ -0,0 +1,636 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package mini
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/DrJosh9000/CSIRAC"
+)
+
+type typ int
+
+const (
+	tVoid typ = iota
+	tInt
+	tFrac
+)
+
+func (t typ) String() string {
+	return [...]string{"void", "int", "frac"}[t]
+}
+
+// Syntax tree.
+type (
+	program struct {
+		globals []*global
+		funcs   []*function
+	}
+
+	global struct {
+		name string
+		typ  typ
+		size int // 0 for scalars
+		init csirac.Word
+		line int
+	}
+
+	function struct {
+		name   string
+		params []*local
+		locals []*local // including params
+		ret    typ
+		body   []stmt
+		calls  map[string]int // callee -> line of (first) call
+		line   int
+	}
+
+	local struct {
+		name string
+		typ  typ
+		reg  int // D register
+	}
+
+	stmt interface{}
+
+	assignStmt struct {
+		target expr // *ident or *index
+		value  expr
+		line   int
+	}
+	ifStmt struct {
+		cond      expr
+		then, els []stmt
+		line      int
+	}
+	whileStmt struct {
+		cond expr
+		body []stmt
+		line int
+	}
+	returnStmt struct {
+		value expr // may be nil
+		line  int
+	}
+	printStmt struct {
+		value expr
+		line  int
+	}
+	exprStmt struct {
+		call *call
+		line int
+	}
+
+	expr interface{}
+
+	intLit  struct{ v int }
+	fracLit struct{ w csirac.Word }
+	ident   struct{ name string }
+	index   struct {
+		name string
+		idx  expr
+	}
+	unary  struct{ x expr }
+	binary struct {
+		op   string
+		l, r expr
+	}
+	call struct {
+		name string
+		args []expr
+	}
+)
+
+type token struct {
+	text string
+	line int
+}
+
+// tokenize splits the source into tokens, dropping comments.
+func tokenize(src string) ([]token, error) {
+	var toks []token
+	line := 1
+	for i := 0; i < len(src); {
+		c := src[i]
+		j := i + 1
+		switch {
+		case c == '\n':
+			line++
+			i++
+			continue
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+			continue
+		case c == '/' && j < len(src) && src[j] == '/':
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+			continue
+		case isDigit(c):
+			for j < len(src) && (isDigit(src[j]) || src[j] == '.') {
+				j++
+			}
+		case isLetter(c):
+			for j < len(src) && (isLetter(src[j]) || isDigit(src[j])) {
+				j++
+			}
+		case strings.IndexByte("=!<>", c) >= 0:
+			if j < len(src) && src[j] == '=' {
+				j++
+			} else if c == '!' {
+				return nil, fmt.Errorf("line %d: unexpected character %q", line, c)
+			}
+		case strings.IndexByte("+-*(){}[],;", c) >= 0:
+		default:
+			return nil, fmt.Errorf("line %d: unexpected character %q", line, c)
+		}
+		toks = append(toks, token{text: src[i:j], line: line})
+		i = j
+	}
+	return toks, nil
+}
+
+func isDigit(c byte) bool  { return '0' <= c && c <= '9' }
+func isLetter(c byte) bool { return c == '_' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' }
+
+var keywords = map[string]bool{
+	"int": true, "frac": true, "func": true, "if": true, "else": true,
+	"while": true, "return": true, "print": true,
+}
+
+func isIdent(s string) bool {
+	return s != "" && isLetter(s[0]) && !keywords[s]
+}
+
+type parser struct {
+	toks []token
+	fn   *function // function being parsed
+}
+
+func (p *parser) peek() string {
+	if len(p.toks) == 0 {
+		return ""
+	}
+	return p.toks[0].text
+}
+
+func (p *parser) line() int {
+	if len(p.toks) == 0 {
+		return 0
+	}
+	return p.toks[0].line
+}
+
+func (p *parser) next() string {
+	t := p.peek()
+	if len(p.toks) > 0 {
+		p.toks = p.toks[1:]
+	}
+	return t
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	if len(p.toks) == 0 {
+		return fmt.Errorf("at end: "+format, args...)
+	}
+	return fmt.Errorf("line %d: "+format, append([]interface{}{p.line()}, args...)...)
+}
+
+func (p *parser) expect(t string) error {
+	if p.peek() != t {
+		return p.errorf("expected %q, got %q", t, p.peek())
+	}
+	p.next()
+	return nil
+}
+
+func (p *parser) ident() (string, error) {
+	if !isIdent(p.peek()) {
+		return "", p.errorf("expected name, got %q", p.peek())
+	}
+	return p.next(), nil
+}
+
+// typeName parses an optional type name.
+func (p *parser) typeName() typ {
+	switch p.peek() {
+	case "int":
+		p.next()
+		return tInt
+	case "frac":
+		p.next()
+		return tFrac
+	}
+	return tVoid
+}
+
+// parse parses a whole program.
+func parse(src string) (*program, error) {
+	toks, err := tokenize(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{toks: toks}
+	prog := new(program)
+	for len(p.toks) > 0 {
+		line := p.line()
+		if p.peek() == "func" {
+			p.next()
+			f, err := p.function()
+			if err != nil {
+				return nil, err
+			}
+			f.line = line
+			prog.funcs = append(prog.funcs, f)
+			p.fn = nil
+			continue
+		}
+		g, err := p.global()
+		if err != nil {
+			return nil, err
+		}
+		g.line = line
+		prog.globals = append(prog.globals, g)
+	}
+	return prog, nil
+}
+
+// global parses: type name ["[" size "]"] ["=" literal] ";"
+func (p *parser) global() (*global, error) {
+	t := p.typeName()
+	if t == tVoid {
+		return nil, p.errorf("expected declaration, got %q", p.peek())
+	}
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	g := &global{name: name, typ: t}
+	switch p.peek() {
+	case "[":
+		p.next()
+		n, err := strconv.Atoi(p.peek())
+		if err != nil || n <= 0 {
+			return nil, p.errorf("invalid array size %q", p.peek())
+		}
+		p.next()
+		g.size = n
+		if err := p.expect("]"); err != nil {
+			return nil, err
+		}
+	case "=":
+		p.next()
+		e, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		w, et, ok := constValue(e)
+		if !ok || et != t {
+			return nil, p.errorf("initial value of %s must be a %v literal", name, t)
+		}
+		g.init = w
+	}
+	return g, p.expect(";")
+}
+
+// function parses: name "(" [type name {"," type name}] ")" [type] block
+func (p *parser) function() (*function, error) {
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	f := &function{name: name, calls: make(map[string]int)}
+	p.fn = f
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	for p.peek() != ")" {
+		if len(f.params) > 0 {
+			if err := p.expect(","); err != nil {
+				return nil, err
+			}
+		}
+		t := p.typeName()
+		if t == tVoid {
+			return nil, p.errorf("expected parameter type, got %q", p.peek())
+		}
+		pn, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		l, err := p.declare(pn, t)
+		if err != nil {
+			return nil, err
+		}
+		f.params = append(f.params, l)
+	}
+	p.next()
+	f.ret = p.typeName()
+	f.body, err = p.block()
+	return f, err
+}
+
+// declare adds a local to the current function.
+func (p *parser) declare(name string, t typ) (*local, error) {
+	for _, l := range p.fn.locals {
+		if l.name == name {
+			return nil, p.errorf("%s already declared in %s", name, p.fn.name)
+		}
+	}
+	l := &local{name: name, typ: t}
+	p.fn.locals = append(p.fn.locals, l)
+	return l, nil
+}
+
+// block parses: "{" {statement} "}"
+func (p *parser) block() ([]stmt, error) {
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	var body []stmt
+	for p.peek() != "}" {
+		if len(p.toks) == 0 {
+			return nil, p.errorf("missing }")
+		}
+		s, err := p.statement()
+		if err != nil {
+			return nil, err
+		}
+		if s != nil {
+			body = append(body, s)
+		}
+	}
+	p.next()
+	return body, nil
+}
+
+func (p *parser) statement() (stmt, error) {
+	line := p.line()
+	switch p.peek() {
+	case "int", "frac":
+		t := p.typeName()
+		name, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.declare(name, t); err != nil {
+			return nil, err
+		}
+		if p.peek() != "=" {
+			return nil, p.expect(";")
+		}
+		p.next()
+		v, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		return &assignStmt{target: &ident{name: name}, value: v, line: line}, p.expect(";")
+
+	case "if":
+		p.next()
+		s := &ifStmt{line: line}
+		var err error
+		if s.cond, err = p.paren(); err != nil {
+			return nil, err
+		}
+		if s.then, err = p.block(); err != nil {
+			return nil, err
+		}
+		if p.peek() != "else" {
+			return s, nil
+		}
+		p.next()
+		if p.peek() == "if" {
+			e, err := p.statement()
+			s.els = []stmt{e}
+			return s, err
+		}
+		s.els, err = p.block()
+		return s, err
+
+	case "while":
+		p.next()
+		s := &whileStmt{line: line}
+		var err error
+		if s.cond, err = p.paren(); err != nil {
+			return nil, err
+		}
+		s.body, err = p.block()
+		return s, err
+
+	case "return":
+		p.next()
+		s := &returnStmt{line: line}
+		if p.peek() != ";" {
+			var err error
+			if s.value, err = p.expr(); err != nil {
+				return nil, err
+			}
+		}
+		return s, p.expect(";")
+
+	case "print":
+		p.next()
+		v, err := p.paren()
+		if err != nil {
+			return nil, err
+		}
+		return &printStmt{value: v, line: line}, p.expect(";")
+	}
+
+	lhs, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	if c, ok := lhs.(*call); ok && p.peek() == ";" {
+		p.next()
+		return &exprStmt{call: c, line: line}, nil
+	}
+	switch lhs.(type) {
+	case *ident, *index:
+	default:
+		return nil, p.errorf("expected assignment")
+	}
+	if err := p.expect("="); err != nil {
+		return nil, err
+	}
+	v, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	return &assignStmt{target: lhs, value: v, line: line}, p.expect(";")
+}
+
+// paren parses: "(" expr ")"
+func (p *parser) paren() (expr, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	e, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	return e, p.expect(")")
+}
+
+// expr parses: sum [comparison sum]
+func (p *parser) expr() (expr, error) {
+	l, err := p.sum()
+	if err != nil {
+		return nil, err
+	}
+	switch op := p.peek(); op {
+	case "==", "!=", "<", "<=", ">", ">=":
+		p.next()
+		r, err := p.sum()
+		if err != nil {
+			return nil, err
+		}
+		return &binary{op: op, l: l, r: r}, nil
+	}
+	return l, nil
+}
+
+// sum parses: product {("+"|"-") product}
+func (p *parser) sum() (expr, error) {
+	l, err := p.product()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "+" || p.peek() == "-" {
+		op := p.next()
+		r, err := p.product()
+		if err != nil {
+			return nil, err
+		}
+		l = &binary{op: op, l: l, r: r}
+	}
+	return l, nil
+}
+
+// product parses: unary {"*" unary}
+func (p *parser) product() (expr, error) {
+	l, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "*" {
+		p.next()
+		r, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		l = &binary{op: "*", l: l, r: r}
+	}
+	return l, nil
+}
+
+// unary parses: "-" unary | primary
+func (p *parser) unary() (expr, error) {
+	if p.peek() == "-" {
+		p.next()
+		x, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		// Fold negative literals.
+		switch x := x.(type) {
+		case *intLit:
+			return &intLit{v: -x.v}, nil
+		case *fracLit:
+			return &fracLit{w: -x.w & 0xfffff}, nil
+		}
+		return &unary{x: x}, nil
+	}
+	return p.primary()
+}
+
+// primary parses: number | name | name "[" expr "]" | name "(" args ")" |
+// "(" expr ")"
+func (p *parser) primary() (expr, error) {
+	t := p.peek()
+	switch {
+	case t == "(":
+		return p.paren()
+
+	case t != "" && isDigit(t[0]):
+		p.next()
+		if strings.Contains(t, ".") {
+			f, err := strconv.ParseFloat(t, 64)
+			if err != nil || f >= 1 {
+				return nil, p.errorf("invalid fraction %q (must be less than 1)", t)
+			}
+			return &fracLit{w: csirac.Word(math.Round(f * (1 << 19)))}, nil
+		}
+		n, err := strconv.Atoi(t)
+		if err != nil || n >= 1<<19 {
+			return nil, p.errorf("invalid integer %q", t)
+		}
+		return &intLit{v: n}, nil
+
+	case isIdent(t):
+		p.next()
+		switch p.peek() {
+		case "[":
+			p.next()
+			i, err := p.expr()
+			if err != nil {
+				return nil, err
+			}
+			return &index{name: t, idx: i}, p.expect("]")
+		case "(":
+			if p.fn == nil {
+				return nil, p.errorf("calls are only allowed in functions")
+			}
+			p.next()
+			c := &call{name: t}
+			for p.peek() != ")" {
+				if len(c.args) > 0 {
+					if err := p.expect(","); err != nil {
+						return nil, err
+					}
+				}
+				a, err := p.expr()
+				if err != nil {
+					return nil, err
+				}
+				c.args = append(c.args, a)
+			}
+			p.next()
+			if _, seen := p.fn.calls[t]; !seen {
+				p.fn.calls[t] = p.line()
+			}
+			return c, nil
+		}
+		return &ident{name: t}, nil
+	}
+	return nil, p.errorf("expected expression, got %q", t)
+}
+
+// constValue returns the value of a literal.
+func constValue(e expr) (csirac.Word, typ, bool) {
+	switch e := e.(type) {
+	case *intLit:
+		return csirac.IntWord(e.v), tInt, true
+	case *fracLit:
+		return e.w, tFrac, true
+	}
+	return 0, tVoid, false
+}
@@ -0,0 +1,63 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package mini
+
+// runtime holds the runtime support routines that aren't in the library (see
+// package stdlib). Each takes its arguments in D12-D15.
+var runtime = map[string]string{
+	// prf prints the fraction D15 in decimal, to 5 places. Each digit is
+	// found by multiplying the fraction by 10 with XB: the upper part of the
+	// product is the digit, and the lower part is the remaining fraction.
+	"prf": `; print fraction
+prf:	27 K  HU     ; H = figures shift
+	0  HL OT
+	15 SD CS     ; if f < 0 { skip next }
+	0  PE PS     ; skip next
+	prf_m M OT   ; print "-"
+	15 D  A      ; A = f
+	0  SA CS     ; if A < 0 { skip next }
+	0  PE PS     ; skip next
+	0  TA SA     ; A = -A
+	15 A  D      ; f = |f|
+	0  SA CS     ; if A < 0 { skip next }
+	prf_p K S    ; goto prf_p
+	prf_c+1 M OT ; print "1" (f was -1, which has no positive)
+	15 Z  D      ; f = 0
+	prf_d K S    ; goto prf_d
+prf_p:	prf_c M OT   ; print "0"
+prf_d:	28 K  HU     ; H = "."
+	0  HL OT
+	5  K  A      ; A = 5 in upper half
+	14 A  D      ; D14 = digits to print
+prf_l:	0  Z  A      ; A = 0
+	10 K  HU     ; H = 10
+	0  HL C      ; C = 10
+	15 D  XB     ; A = digit, B = remaining fraction (shifted left)
+	15 RB D      ; f = remaining fraction
+	0  A  HL     ; H = digit
+	0  HU PK     ; next command += digit
+	prf_c M OT   ; print digit
+	14 PE SD     ; D14--
+	14 D  A      ; A = D14
+	0  ZA CS     ; if A != 0 { skip next }
+	prf_x K S    ; goto prf_x
+	prf_l K S    ; goto prf_l
+prf_x:	0  Z  Z      ; return
+prf_m:	.word 3      ; "-"
+prf_c:	.word 22, 23, 19, 1, 10, 16, 21, 7, 6, 24 ; "0" to "9"
+`,
+}
@@ -0,0 +1,141 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package csirac
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// RelocField says which part of a word a relocation applies to.
+type RelocField int
+
+const (
+	// FieldAddr is the 10-bit address field of an instruction (p11-p20).
+	// Relocated addresses wrap around modulo 1024.
+	FieldAddr RelocField = iota
+
+	// FieldWord is the whole word, such as a data word holding an address.
+	FieldWord
+)
+
+// Reloc is a relocation entry: a place in an object where the address at
+// which something was placed must be added in by the linker.
+type Reloc struct {
+	// Addr is the address of the word within the object.
+	Addr int
+
+	// Field is the part of the word to relocate.
+	Field RelocField
+
+	// Label is the imported label whose address is added. If empty, the
+	// address the object is placed at is added.
+	Label string `json:",omitempty"`
+}
+
+// Object is a relocatable program, assembled as though it begins at address
+// 0. Objects are combined into a loadable image by a linker (see package
+// link).
+type Object struct {
+	Program
+
+	// Exports lists the labels that other objects may import.
+	Exports []string `json:",omitempty"`
+
+	// Imports lists the labels that must be provided by other objects.
+	Imports []string `json:",omitempty"`
+
+	// Relocs lists the words that depend on where the object, or the objects
+	// it imports from, are placed.
+	Relocs []Reloc `json:",omitempty"`
+
+	// Overlay is the name of the overlay segment, if the object is one.
+	Overlay string `json:",omitempty"`
+}
+
+// AssembleObject assembles a relocatable object. The source is written as for
+// Assemble, with two directives controlling which labels are shared between
+// objects:
+//
+//	.export l, ...  labels defined in this object that others may use
+//	.import l, ...  labels defined in other objects that this one uses
+//
+// The directive ".overlay name" makes the object an overlay segment: it is
+// kept on a drum and copied into the main store when needed (see package
+// link).
+//
+// Imported labels can be used in address expressions and data words, either
+// alone or plus or minus a constant. Labels defined in the object can be used
+// in the same way, or subtracted from one another to give a constant.
+func AssembleObject(src io.Reader) (*Object, error) {
+	a := &assembler{
+		p:       &Program{Labels: make(map[string]int)},
+		obj:     true,
+		imports: make(map[string]bool),
+	}
+	if err := a.assemble(src); err != nil {
+		return nil, err
+	}
+	o := &Object{
+		Program: *a.p,
+		Exports: a.exports,
+		Relocs:  a.relocs,
+		Overlay: a.overlay,
+	}
+	for l := range a.imports {
+		o.Imports = append(o.Imports, l)
+	}
+	sort.Strings(o.Imports)
+	return o, nil
+}
+
+// ReadObject reads an object in the format written by WriteObject.
+func ReadObject(r io.Reader) (*Object, error) {
+	o := new(Object)
+	if err := json.NewDecoder(r).Decode(o); err != nil {
+		return nil, err
+	}
+	if len(o.Lines) != len(o.Words) {
+		return nil, fmt.Errorf("object has %d words but %d line numbers", len(o.Words), len(o.Lines))
+	}
+	if o.Data != nil && len(o.Data) != len(o.Words) {
+		return nil, fmt.Errorf("object has %d words but %d data marks", len(o.Words), len(o.Data))
+	}
+	if len(o.Words) > 1024 {
+		return nil, fmt.Errorf("object has %d words, more than 1024", len(o.Words))
+	}
+	for i, w := range o.Words {
+		if w&^allBits != 0 {
+			return nil, fmt.Errorf("word %d: value %#x exceeds 20 bits", i, uint32(w))
+		}
+	}
+	for _, rl := range o.Relocs {
+		if rl.Addr < 0 || rl.Addr >= len(o.Words) {
+			return nil, fmt.Errorf("relocation address %d out of range [0,%d)", rl.Addr, len(o.Words))
+		}
+	}
+	return o, nil
+}
+
+// WriteObject writes an object, as JSON.
+func WriteObject(w io.Writer, o *Object) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "\t")
+	return enc.Encode(o)
+}
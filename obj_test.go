@@ -0,0 +1,116 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package csirac
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestAssembleObject(t *testing.T) {
+	o, err := AssembleObject(strings.NewReader(`
+		.export start, data
+		.import sub, sub_x, table
+	start:	ret M A
+		sub_x A M
+		sub K S
+	ret:	.+1 K S
+		table+2 MA A
+		data A M
+		0 PL T
+	data:	.word 0, start, table, data-start
+	`))
+	if err != nil {
+		t.Fatalf("AssembleObject() = %v", err)
+	}
+	if got, want := o.Exports, []string{"start", "data"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("o.Exports = %v, want %v", got, want)
+	}
+	if got, want := o.Imports, []string{"sub", "sub_x", "table"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("o.Imports = %v, want %v", got, want)
+	}
+	wantRelocs := []Reloc{
+		{Addr: 0, Field: FieldAddr},
+		{Addr: 1, Field: FieldAddr, Label: "sub_x"},
+		{Addr: 2, Field: FieldAddr, Label: "sub"},
+		{Addr: 3, Field: FieldAddr},
+		{Addr: 4, Field: FieldAddr, Label: "table"},
+		{Addr: 5, Field: FieldAddr},
+		{Addr: 8, Field: FieldWord},
+		{Addr: 9, Field: FieldWord, Label: "table"},
+	}
+	if got := o.Relocs; !reflect.DeepEqual(got, wantRelocs) {
+		t.Errorf("o.Relocs = %v, want %v", got, wantRelocs)
+	}
+	if got, want := o.Words[4], MustParseInstruction("0 2 MA A"); got != want {
+		t.Errorf("o.Words[4] = %v, want %v", got, want)
+	}
+
+	o2, err := AssembleObject(strings.NewReader(".overlay seg\n0 PL T"))
+	if err != nil {
+		t.Fatalf("AssembleObject(.overlay seg) = %v", err)
+	}
+	if got, want := o2.Overlay, "seg"; got != want {
+		t.Errorf("o2.Overlay = %q, want %q", got, want)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteObject(&buf, o); err != nil {
+		t.Fatalf("WriteObject() = %v", err)
+	}
+	got, err := ReadObject(&buf)
+	if err != nil {
+		t.Fatalf("ReadObject() = %v", err)
+	}
+	if !reflect.DeepEqual(got, o) {
+		t.Errorf("ReadObject(WriteObject(o)) = %+v, want %+v", got, o)
+	}
+}
+
+func TestAssembleObjectErrors(t *testing.T) {
+	tests := []string{
+		".import x\nx: .word 0",    // defined and imported
+		".import x\n.import x",     // imported twice
+		".export x",                // not defined
+		".export x, x\nx: .word 0", // exported twice
+		".import x\n.org x",        // .org at an import
+		".import x\n-x K S",        // negative import
+		".import x, y\nx+y K S",    // two imports
+		"a: .word a+a",             // two relocatable labels
+		".import x\na: .word x+a",  // import and relocatable label
+		".export",                  // no labels
+		".import 1x",               // invalid label
+	}
+	for _, test := range tests {
+		if _, err := AssembleObject(strings.NewReader(test)); err == nil {
+			t.Errorf("AssembleObject(%q) = nil error, want error", test)
+		}
+	}
+	for _, test := range []string{".import x", ".overlay x"} {
+		if _, err := Assemble(strings.NewReader(test)); err == nil {
+			t.Errorf("Assemble(%q) = nil error, want error", test)
+		}
+	}
+	if _, err := ReadObject(strings.NewReader(`{"Words": [2000000], "Lines": [1]}`)); err == nil {
+		t.Errorf("ReadObject(too big word) = nil error, want error")
+	}
+	if _, err := ReadObject(strings.NewReader(`{"Words": [1], "Lines": [1], "Data": [true, false]}`)); err == nil {
+		t.Errorf("ReadObject(too many data marks) = nil error, want error")
+	}
+}
@@ -0,0 +1,231 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package profile counts where a program spends its time: how often each
+// instruction in the main store is executed, how often each kind of
+// instruction is executed, how often the drums are used, and which loops
+// are hot. Hot loops are the ones worth rewriting to keep their working
+// values in the D registers instead of the main store.
+package profile
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/DrJosh9000/CSIRAC"
+)
+
+// Loop is a backward jump, from the instruction at End to the instruction
+// at Start. The addresses from Start to End make up the body of the loop.
+type Loop struct {
+	Start, End int
+}
+
+// Drum counts the accesses to one drum.
+type Drum struct {
+	Reads, Writes int64
+}
+
+// Profile counts the instructions executed by a machine.
+type Profile struct {
+	// Total is the number of instructions executed.
+	Total int64
+
+	// Counts holds the number of times the instruction at each address in
+	// the main store was executed.
+	Counts [1024]int64
+
+	// Pairs holds the number of times each combination of source and
+	// destination was executed, indexed by the lower half of the
+	// instruction (source<<5 | destination).
+	Pairs [1024]int64
+
+	// Drums counts the accesses to MA, MB, MC and MD.
+	Drums [4]Drum
+
+	// Loops holds the number of times each backward jump was taken.
+	Loops map[Loop]int64
+}
+
+// New returns an empty profile.
+func New() *Profile {
+	return &Profile{Loops: make(map[Loop]int64)}
+}
+
+// Step counts the instruction in K, and executes it with c.Step.
+func (p *Profile) Step(c *csirac.CSIRAC) error {
+	k, addr := c.K, int(c.S.Hi())
+	err := c.Step()
+	var be *csirac.ErrBudgetExceeded
+	if errors.As(err, &be) {
+		// Nothing was executed.
+		return err
+	}
+	p.Total++
+	p.Counts[addr]++
+	p.Pairs[k.Lo()]++
+	if s := k.Source(); s >= 27 && s <= 30 {
+		p.Drums[s-27].Reads++
+	}
+	if d := k.Dest(); d >= 27 && d <= 30 {
+		p.Drums[d-27].Writes++
+	}
+	if err != nil {
+		return err
+	}
+	if next := int(c.S.Hi()); next <= addr {
+		p.Loops[Loop{Start: next, End: addr}]++
+	}
+	return nil
+}
+
+// Run runs the machine until it stops, like c.Run(0, false), profiling each
+// instruction. Set a budget on the machine if the program might not stop.
+func (p *Profile) Run(c *csirac.CSIRAC) error {
+	for {
+		if err := p.Step(c); err != nil {
+			if errors.Is(err, csirac.ErrStop) {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// barWidth is the width of the bars in the loop summary, at 100%.
+const barWidth = 40
+
+// WriteReport writes a report of the profile. If prog is not nil, each
+// address is annotated with the line of source it was assembled from;
+// source holds the lines of the source (source[0] is line 1).
+//
+// The report has four sections:
+//
+//   - every address executed, with its count and share of the total;
+//   - the source and destination combinations, most executed first;
+//   - the drum accesses; and
+//   - the loops, outermost first with the loops inside them indented below,
+//     each with a bar showing the share of instructions executed in its body.
+func (p *Profile) WriteReport(w io.Writer, prog *csirac.Program, source []string) error {
+	pct := func(n int64) float64 {
+		if p.Total == 0 {
+			return 0
+		}
+		return 100 * float64(n) / float64(p.Total)
+	}
+	line := func(addr int) string {
+		if prog == nil || addr >= len(prog.Lines) || prog.Lines[addr] == 0 {
+			return ""
+		}
+		l := prog.Lines[addr]
+		if l > len(source) {
+			return fmt.Sprintf("%5d", l)
+		}
+		return fmt.Sprintf("%5d  %s", l, strings.TrimSpace(source[l-1]))
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d instructions (%v simulated)\n", p.Total, time.Duration(p.Total)*csirac.InstructionTime)
+
+	b.WriteString("\naddr       count      %   line\n")
+	for addr, n := range p.Counts {
+		if n == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "%4d  %10d  %5.1f  %s\n", addr, n, pct(n), line(addr))
+	}
+
+	b.WriteString("\ninstruction     count      %\n")
+	pairs := make([]int, 0, len(p.Pairs))
+	for lo, n := range p.Pairs {
+		if n != 0 {
+			pairs = append(pairs, lo)
+		}
+	}
+	sort.SliceStable(pairs, func(i, j int) bool { return p.Pairs[pairs[i]] > p.Pairs[pairs[j]] })
+	for _, lo := range pairs {
+		src, dst := csirac.Word(lo).Mnemonics()
+		fmt.Fprintf(&b, "%-2s %-2s      %10d  %5.1f\n", src, dst, p.Pairs[lo], pct(p.Pairs[lo]))
+	}
+
+	b.WriteString("\ndrum       reads     writes\n")
+	for i, d := range p.Drums {
+		fmt.Fprintf(&b, "M%c    %10d %10d\n", 'A'+i, d.Reads, d.Writes)
+	}
+
+	b.WriteString("\nloop                    body      %   jumps\n")
+	for _, nl := range p.nest() {
+		n := p.body(nl.Loop)
+		bar := int(pct(n)*barWidth/100 + 0.5)
+		fmt.Fprintf(&b, "%s%4d-%-4d %*s %10d  %5.1f  %6d  %s\n",
+			strings.Repeat("  ", nl.depth), nl.Start, nl.End, 2*(maxDepth-nl.depth), "",
+			n, pct(n), p.Loops[nl.Loop], strings.Repeat("#", bar))
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// maxDepth is the deepest nesting of loops shown with indentation. Deeper
+// loops are shown at this depth.
+const maxDepth = 4
+
+// nestedLoop is a loop and how many loops it is inside.
+type nestedLoop struct {
+	Loop
+	depth int
+}
+
+// nest returns the loops in order, each followed by the loops inside it.
+func (p *Profile) nest() []nestedLoop {
+	loops := make([]Loop, 0, len(p.Loops))
+	for l := range p.Loops {
+		loops = append(loops, l)
+	}
+	sort.Slice(loops, func(i, j int) bool {
+		if loops[i].Start != loops[j].Start {
+			return loops[i].Start < loops[j].Start
+		}
+		return loops[i].End > loops[j].End
+	})
+	var out []nestedLoop
+	var stack []Loop // the loops enclosing the current one
+	for _, l := range loops {
+		for len(stack) > 0 && stack[len(stack)-1].End < l.End {
+			stack = stack[:len(stack)-1]
+		}
+		depth := len(stack)
+		if depth > maxDepth {
+			depth = maxDepth
+		}
+		out = append(out, nestedLoop{Loop: l, depth: depth})
+		stack = append(stack, l)
+	}
+	return out
+}
+
+// body returns the number of instructions executed in the body of the loop.
+func (p *Profile) body(l Loop) int64 {
+	var n int64
+	for addr := l.Start; addr <= l.End; addr++ {
+		n += p.Counts[addr]
+	}
+	return n
+}
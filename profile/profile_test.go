@@ -0,0 +1,162 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package profile
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/DrJosh9000/CSIRAC"
+)
+
+func TestProfile(t *testing.T) {
+	// The count down loop from the programming guide, saving A on a drum
+	// each time around.
+	src := `start:	8 K C		; C = 8
+loop:	B PA		; A += B
+	3 A MA		; MA[3] = A
+	PE SC		; C--
+	SC CS		; if C < 0 { skip next }
+	loop K S	; goto loop
+	1023 K T	; stop
+	.word 0
+`
+	prog, err := csirac.Assemble(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("Assemble() = %v", err)
+	}
+	c := &csirac.CSIRAC{M: prog.Words, MA: make([]csirac.Word, 4), B: 1}
+	c.K = c.M[0]
+	p := New()
+	if err := p.Run(c); err != nil {
+		t.Fatalf("p.Run() = %v", err)
+	}
+
+	if got, want := p.Total, int64(46); got != want {
+		t.Errorf("p.Total = %d, want %d", got, want)
+	}
+	if got, want := p.Counts[:8], []int64{1, 9, 9, 9, 9, 8, 1, 0}; !reflect.DeepEqual(got, want) {
+		t.Errorf("p.Counts[:8] = %v, want %v", got, want)
+	}
+	pair := func(inst string) int64 {
+		return p.Pairs[csirac.MustParseInstruction(inst).Lo()]
+	}
+	if got := pair("0 0 A MA"); got != 9 {
+		t.Errorf("A MA executed %d times, want 9", got)
+	}
+	if got := pair("0 0 K S"); got != 8 {
+		t.Errorf("K S executed %d times, want 8", got)
+	}
+	if got, want := p.Drums, [4]Drum{{Writes: 9}, {}, {}, {}}; got != want {
+		t.Errorf("p.Drums = %v, want %v", got, want)
+	}
+	if got, want := p.Loops, map[Loop]int64{{Start: 1, End: 5}: 8}; !reflect.DeepEqual(got, want) {
+		t.Errorf("p.Loops = %v, want %v", got, want)
+	}
+
+	var sb strings.Builder
+	if err := p.WriteReport(&sb, prog, strings.Split(src, "\n")); err != nil {
+		t.Fatalf("WriteReport() = %v", err)
+	}
+	report := sb.String()
+	for _, want := range []string{
+		"46 instructions (46ms simulated)\n",
+		"   2           9   19.6      3  3 A MA		; MA[3] = A\n",
+		"A  MA               9   19.6\n",
+		"MA             0          9\n",
+		"   1-5                     44   95.7       8  " + strings.Repeat("#", 38) + "\n",
+	} {
+		if !strings.Contains(report, want) {
+			t.Errorf("report doesn't contain %q:\n%s", want, report)
+		}
+	}
+}
+
+func TestProfileBudget(t *testing.T) {
+	prog, err := csirac.Assemble(strings.NewReader(`loop:	B PA		; A += B
+	3 A MA		; MA[3] = A
+	loop K S	; goto loop
+	.word 0
+`))
+	if err != nil {
+		t.Fatalf("Assemble() = %v", err)
+	}
+	c := &csirac.CSIRAC{
+		M:      prog.Words,
+		MA:     make([]csirac.Word, 4),
+		Budget: csirac.Budget{Instructions: 5},
+	}
+	c.K = c.M[0]
+	p := New()
+	var be *csirac.ErrBudgetExceeded
+	if err := p.Run(c); !errors.As(err, &be) {
+		t.Fatalf("p.Run() = %v, want *csirac.ErrBudgetExceeded", err)
+	}
+
+	// The instruction that would have exceeded the budget isn't counted.
+	if got, want := p.Total, int64(5); got != want {
+		t.Errorf("p.Total = %d, want %d", got, want)
+	}
+	if got, want := p.Counts[:3], []int64{2, 2, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("p.Counts[:3] = %v, want %v", got, want)
+	}
+	if got, want := p.Drums[0], (Drum{Writes: 2}); got != want {
+		t.Errorf("p.Drums[0] = %v, want %v", got, want)
+	}
+}
+
+func TestNest(t *testing.T) {
+	tests := []struct {
+		name  string
+		loops []Loop
+		want  []nestedLoop
+	}{
+		{
+			name:  "separate",
+			loops: []Loop{{10, 20}, {1, 5}},
+			want:  []nestedLoop{{Loop{1, 5}, 0}, {Loop{10, 20}, 0}},
+		},
+		{
+			name:  "nested",
+			loops: []Loop{{3, 4}, {1, 9}, {2, 6}, {7, 8}},
+			want:  []nestedLoop{{Loop{1, 9}, 0}, {Loop{2, 6}, 1}, {Loop{3, 4}, 2}, {Loop{7, 8}, 1}},
+		},
+		{
+			name:  "same start",
+			loops: []Loop{{1, 3}, {1, 8}},
+			want:  []nestedLoop{{Loop{1, 8}, 0}, {Loop{1, 3}, 1}},
+		},
+		{
+			name:  "overlapping",
+			loops: []Loop{{1, 5}, {3, 8}},
+			want:  []nestedLoop{{Loop{1, 5}, 0}, {Loop{3, 8}, 0}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := New()
+			for _, l := range test.loops {
+				p.Loops[l]++
+			}
+			if got := p.nest(); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("p.nest() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package csirac
+
+import (
+	"bufio"
+	"io"
+)
+
+// Punch is a five-hole paper tape punch. Each word sent to it punches one row
+// of tape from the digits in positions 1-5 (hole n is punched if pn is 1).
+// It is a Device; to attach it to a machine, call c.Attach(p).
+type Punch struct {
+	// Rows holds the punched rows so far. Hole n is bit n-1.
+	Rows []byte
+}
+
+// Punch punches a row of tape from digits 1-5 of w.
+func (p *Punch) Punch(w Word) {
+	p.Rows = append(p.Rows, byte(w&0x1f))
+}
+
+// Event punches the word in each EventPunch.
+func (p *Punch) Event(c *CSIRAC, e Event) error {
+	if e.Kind == EventPunch {
+		p.Punch(e.Word)
+	}
+	return nil
+}
+
+// WriteRaw writes the tape as raw bytes, one byte per row.
+func (p *Punch) WriteRaw(w io.Writer) error {
+	_, err := w.Write(p.Rows)
+	return err
+}
+
+// WriteHoles writes an ASCII-art rendering of the tape, one line per row.
+// Holes 1 through 5 are drawn left to right, punched holes as 'o' and
+// unpunched positions as ' ', with the sprocket hole (between holes 2 and 3)
+// drawn as '.'. For example, a row with holes 1, 3 and 4 punched is drawn as
+// "|o .oo |".
+func (p *Punch) WriteHoles(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	for _, r := range p.Rows {
+		line := []byte("|  .   |\n")
+		for n, i := range [5]int{1, 2, 4, 5, 6} {
+			if r&(1<<n) != 0 {
+				line[i] = 'o'
+			}
+		}
+		if _, err := bw.Write(line); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// WriteInputTape writes the tape in the format read by ReadInputTape, so that
+// output tapes can be loaded into the tape reader. Holes 1-5 of the output
+// tape become holes 1-5 of the input tape.
+func (p *Punch) WriteInputTape(w io.Writer) error {
+	t := &InputTape{Rows: make([]uint16, len(p.Rows))}
+	for i, r := range p.Rows {
+		t.Rows[i] = uint16(r)
+	}
+	return t.WriteRaw(w)
+}
@@ -0,0 +1,126 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package csirac
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// punchSomeRows runs a short program that punches three rows.
+func punchSomeRows(t *testing.T) *Punch {
+	t.Helper()
+	program := MustParseProgram(`
+		 0  0 PL OP  ; punch hole 1
+		 0 19 K  HU  ; H = 19
+		 0  0 HL OP  ; punch holes 1, 2, 5
+		 0  0 Z  OP  ; punch blank row
+		 0  0 PL T   ; stop
+	`)
+	p := new(Punch)
+	c := &CSIRAC{
+		M: append(program, 0), // Step fetches past the stop
+		K: program[0],
+	}
+	c.Attach(p)
+	if err := c.Run(0, false); err != nil {
+		t.Fatalf("c.Run(0) = %v, want nil", err)
+	}
+	return p
+}
+
+func TestPunchFormats(t *testing.T) {
+	p := punchSomeRows(t)
+
+	if got, want := p.Rows, []byte{1, 19, 0}; !bytes.Equal(got, want) {
+		t.Errorf("p.Rows = %v, want %v", got, want)
+	}
+
+	var raw bytes.Buffer
+	if err := p.WriteRaw(&raw); err != nil {
+		t.Fatalf("p.WriteRaw() = %v", err)
+	}
+	if got, want := raw.Bytes(), []byte{1, 19, 0}; !bytes.Equal(got, want) {
+		t.Errorf("p.WriteRaw() wrote %v, want %v", got, want)
+	}
+
+	var holes strings.Builder
+	if err := p.WriteHoles(&holes); err != nil {
+		t.Fatalf("p.WriteHoles() = %v", err)
+	}
+	wantHoles := "" +
+		"|o .   |\n" +
+		"|oo.  o|\n" +
+		"|  .   |\n"
+	if got := holes.String(); got != wantHoles {
+		t.Errorf("p.WriteHoles() wrote\n%s\nwant\n%s", got, wantHoles)
+	}
+}
+
+func TestPunchToInputTape(t *testing.T) {
+	p := punchSomeRows(t)
+
+	var buf bytes.Buffer
+	if err := p.WriteInputTape(&buf); err != nil {
+		t.Fatalf("p.WriteInputTape() = %v", err)
+	}
+	tape, err := ReadInputTape(&buf)
+	if err != nil {
+		t.Fatalf("ReadInputTape() = %v", err)
+	}
+
+	// Read the tape back in, one row per D register, with an extra read
+	// past the end of the tape.
+	program := MustParseProgram(`
+		 0  0 I  D   ; D0 = next row
+		 0  1 I  D   ; D1 = next row
+		 0  2 I  D   ; D2 = next row
+		 0  3 I  D   ; D3 = next row (blank)
+		 0  0 PL T   ; stop
+	`)
+	c := &CSIRAC{
+		M:     append(program, 0), // Step fetches past the stop
+		K:     program[0],
+		D:     [16]Word{3: 99},
+		Input: tape,
+	}
+	if err := c.Run(0, false); err != nil {
+		t.Fatalf("c.Run(0) = %v, want nil", err)
+	}
+	for i, want := range []Word{1, 19, 0, 0} {
+		if got := c.D[i]; got != want {
+			t.Errorf("after Run: c.D[%d] = %d, want %d", i, got, want)
+		}
+	}
+	if got, want := tape.Pos, 3; got != want {
+		t.Errorf("after Run: tape.Pos = %d, want %d", got, want)
+	}
+}
+
+func TestReadInputTapeErrors(t *testing.T) {
+	tests := [][]byte{
+		{0x01},             // odd length
+		{0x00, 0x10},       // hole 13
+		{0x01, 0x00, 0xff}, // odd length again
+	}
+	for _, test := range tests {
+		if _, err := ReadInputTape(bytes.NewReader(test)); err == nil {
+			t.Errorf("ReadInputTape(%v) = nil error, want error", test)
+		}
+	}
+}
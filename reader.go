@@ -0,0 +1,104 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package csirac
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/bits"
+)
+
+// InputTape is a length of 12-hole paper tape loaded into the tape reader.
+type InputTape struct {
+	// Rows holds the rows of the tape. Hole n is bit n-1.
+	Rows []uint16
+
+	// Pos is the index of the next row to be read.
+	Pos int
+}
+
+// ReadInputTape reads a 12-hole tape stored as two bytes per row
+// (little-endian, hole n in bit n-1).
+func ReadInputTape(r io.Reader) (*InputTape, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(b)%2 != 0 {
+		return nil, fmt.Errorf("input tape has odd length %d", len(b))
+	}
+	t := &InputTape{Rows: make([]uint16, len(b)/2)}
+	for i := range t.Rows {
+		row := binary.LittleEndian.Uint16(b[2*i:])
+		if row&^0xfff != 0 {
+			return nil, fmt.Errorf("row %d: holes beyond 12 punched (%#04x)", i, row)
+		}
+		t.Rows[i] = row
+	}
+	return t, nil
+}
+
+// WriteRaw writes the tape in the format read by ReadInputTape.
+func (t *InputTape) WriteRaw(w io.Writer) error {
+	buf := make([]byte, 2*len(t.Rows))
+	for i, row := range t.Rows {
+		binary.LittleEndian.PutUint16(buf[2*i:], row)
+	}
+	_, err := w.Write(buf)
+	return err
+}
+
+// Next advances the tape by one row and returns the row that was read, with
+// hole n in bit pn. Past the end of the tape (or if there is no tape), the
+// reader sees blank tape and Next returns 0.
+func (t *InputTape) Next() Word {
+	if t == nil || t.Pos >= len(t.Rows) {
+		return 0
+	}
+	r := t.Rows[t.Pos]
+	t.Pos++
+	return Word(r)
+}
+
+// WordsToRows encodes words onto 12-hole tape, two rows per word. The first
+// row carries the upper half of the word (p11-p20) in holes 1-10, and the
+// second row carries the lower half (p1-p10). The control holes (11 and 12)
+// are not punched.
+func WordsToRows(ws []Word) []uint16 {
+	rows := make([]uint16, 0, 2*len(ws))
+	for _, w := range ws {
+		rows = append(rows, uint16(w.Hi()), uint16(w.Lo()))
+	}
+	return rows
+}
+
+// DecimalRow returns a 12-hole row representing the decimal digit d (0-9),
+// as read in decimal input mode. Digit d is punched as hole d+1.
+func DecimalRow(d int) uint16 { return 1 << d }
+
+// decimalDigit interprets a row in decimal input mode. The lowest punched
+// data hole (1-10) gives the digit, which is returned in p1-p4. Holes 11 and
+// 12 are passed through as p11 and p12. A row with no data holes reads as 0.
+func decimalDigit(row Word) Word {
+	ctrl := row & 0xc00
+	data := uint32(row & 0x3ff)
+	if data == 0 {
+		return ctrl
+	}
+	return Word(bits.TrailingZeros32(data)) | ctrl
+}
@@ -0,0 +1,233 @@
+/*
+   Copyright 2021 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package csirac
+
+// This is a copy of the interpreter that decodes the source and destination of
+// each instruction with a switch, instead of through the tables in csirac.go
+// and dispatch.go. Step is tested against it, and benchmarked against it, so
+// any change to a source or destination in csirac.go must be made here too.
+
+// refStep is Step, decoding the instruction with refSource and refDest.
+func refStep(c *CSIRAC) error {
+	c.Instructions++
+	inst := c.K
+	src := refSource(c)
+	c.S = (c.S + P(11)) & allBits
+	c.K = c.M[c.S.Hi()]
+	return c.deviceError(refDest(c, inst, src))
+}
+
+// refSource is ReadSource, as a switch.
+func refSource(c *CSIRAC) Word {
+	switch c.K.Source() {
+	case 0: // n M - Read from main store
+		return c.M[c.K.Hi()]
+	case 1: // I - Read input register
+		row := -1
+		if c.Input != nil && c.Input.Pos < len(c.Input.Rows) {
+			row = c.Input.Pos
+		}
+		c.I = c.Input.Next()
+		if c.Decimal {
+			c.I = decimalDigit(c.I)
+		}
+		c.event(EventTapeRead, 0, row, c.I)
+		c.event(EventSwitchRead, 2, 0, c.IS)
+		return c.I | c.IS
+	case 2: // NA - Read switch register 1
+		c.event(EventSwitchRead, 0, 0, c.NA)
+		return c.NA
+	case 3: // NB - Read switch register 2
+		c.event(EventSwitchRead, 1, 0, c.NB)
+		return c.NB
+	case 4: // A - Read the A register
+		return c.A
+	case 5: // SA - Read the sign bit of the A register
+		if c.SignAtP1 {
+			return c.A.P(20)
+		}
+		return c.A & signBit
+	case 6: // HA - "Half A" - Read the A register shifted right
+		return (c.A >> 1) | (c.A & signBit)
+	case 7: // TA - Read the A register shifted left
+		return (c.A << 1) & allBits
+	case 8: // LA - Read the Least significant bit of the A register
+		return c.A & 1
+	case 9: // CA - Read A then clear it
+		a := c.A
+		c.A = 0
+		return a
+	case 10: // ZA - Nonzero-test A, report result as a single bit
+		if c.A == 0 {
+			return 0
+		}
+		return 1
+	case 11: // B - Read the B register
+		return c.B
+	case 12: // R - Read the sign bit of the B register
+		return c.B.P(20)
+	case 13: // RB - Read the B register shifted right (logical shift)
+		return c.B >> 1
+	case 14: // C - Read the C register
+		return c.C
+	case 15: // SC - Read the sign bit of the C register
+		if c.SignAtP1 {
+			return c.C.P(20)
+		}
+		return c.C & signBit
+	case 16: // RC - Read the C register shifted right (logical shift)
+		return c.C >> 1
+	case 17: // n D - Read from one of the D registers
+		return c.D[c.K.Hi()&0xF]
+	case 18: // n SD - Read the sign bit of one of the D registers
+		if c.SignAtP1 {
+			return c.D[c.K.Hi()&0xF].P(20)
+		}
+		return c.D[c.K.Hi()&0xF] & signBit
+	case 19: // n RD - Read one of the D registers shifted right (logical shift)
+		return c.D[c.K.Hi()&0xF] >> 1
+	case 20: // Z - Read zero.
+		return 0
+	case 21: // HL - Read the H register as a lower half
+		return c.H
+	case 22: // HU - Read the H register as an upper half
+		return c.H << 10
+	case 23: // S - Read sequence register
+		return c.S
+	case 24: // PE - Read "upper" 1 (P-Eleven)
+		return P(11)
+	case 25: // PL - Read 1 (P-Least)
+		return 1
+	case 26: // n K - Read the upper half of the instruction (a literal)
+		return c.K & hi10
+	case 27: // n MA - Read disk 1
+		w := c.MA[c.K.Hi()]
+		c.event(EventDrumRead, 0, int(c.K.Hi()), w)
+		return w
+	case 28: // n MB - Read disk 2
+		w := c.MB[c.K.Hi()]
+		c.event(EventDrumRead, 1, int(c.K.Hi()), w)
+		return w
+	case 29: // n MC - Read disk 3
+		w := c.MC[c.K.Hi()]
+		c.event(EventDrumRead, 2, int(c.K.Hi()), w)
+		return w
+	case 30: // n MD - Read disk 4
+		w := c.MD[c.K.Hi()]
+		c.event(EventDrumRead, 3, int(c.K.Hi()), w)
+		return w
+	case 31: // PS - Read a number with 1 in the sign bit (P-Sign)
+		return signBit
+	}
+	panic("k.Source returned a number outside [0, 31]")
+}
+
+// refDest is WriteDest, as a switch.
+func refDest(c *CSIRAC, inst, src Word) error {
+	switch inst.Dest() {
+	case 0: // n M - Write to main store
+		c.M[inst.Hi()] = src
+	case 1: // Q - Set binary or decimal input
+		c.Decimal = src != 0
+	case 2: // OT - Write to console printer
+		c.event(EventPrint, 0, 0, src)
+	case 3: // OP - Write to tape punch
+		c.event(EventPunch, 0, 0, src)
+	case 4: // A - Write to A register
+		c.A = src
+	case 5: // PA - Add into A register
+		c.A = (c.A + src) & allBits
+	case 6: // SA - Subtract into A register
+		c.A = (c.A - src) & allBits
+	case 7: // CA - AND with A register (C for Conjunction)
+		c.A &= src
+	case 8: // DA - OR with A register (D for Disjunction)
+		c.A |= src
+	case 9: // NA - XOR with A register (N for negation)
+		c.A ^= src
+	case 10: // P - Loudspeaker
+		c.event(EventSound, 0, 0, src)
+	case 11: // B - Write into B register
+		c.B = src
+	case 12: // XB - Multiplication.
+		sign := (src & signBit) ^ (c.C & signBit)
+		prod := uint64(src&^signBit) * uint64(c.C&^signBit)
+		c.A = (c.A + sign + Word(prod>>19)) & allBits
+		c.B = Word(prod<<1) & allBits
+	case 13: // L - "A and B shifted 1 left IF source bit 20 is set"
+		if src.P(20) != 1 {
+			break
+		}
+		n := (src.Hi()>>1)&0xf + 1
+		a := c.A << n
+		b := c.B << n
+		c.A = (a + (b >> 20)) & allBits
+		c.B = (b + (a >> 20)) & allBits
+	case 14: // C - Write into C register
+		c.C = src
+	case 15: // PC - Add into C register
+		c.C = (c.C + src) & allBits
+	case 16: // SC - Subtract into C register
+		c.C = (c.C - src) & allBits
+	case 17: // n D - Write into a D register
+		c.D[inst.Hi()&0xf] = src
+	case 18: // n PD - Add into a D register
+		c.D[inst.Hi()&0xf] = (c.D[inst.Hi()&0xf] + src) & allBits
+	case 19: // n SD - Subtract into a D register
+		c.D[inst.Hi()&0xf] = (c.D[inst.Hi()&0xf] - src) & allBits
+	case 20: // Z - Null
+	case 21: // HL - H as lower half
+		c.H = src.Lo()
+	case 22: // HU - H as upper half
+		c.H = src.Hi()
+	case 23: // S - Write into sequence register (absolute jump)
+		c.S = src
+		c.K = c.M[c.S.Hi()]
+	case 24: // PS - Add into sequence register (relative jump)
+		c.S = (c.S + src) & allBits
+		c.K = c.M[c.S.Hi()]
+	case 25: // CS - Conditionally increase sequence register
+		if src&0b00000_00001_11111_11111 != 0 { // p1 - p11
+			c.S = (c.S + P(11)) & allBits
+		}
+		if src&0b11111_10000_00000_00000 != 0 { // p15 - p20
+			c.S = (c.S + P(11)) & allBits
+		}
+		c.K = c.M[c.S.Hi()]
+	case 26: // PK - Add into instruction register
+		c.K = (c.K + src) & allBits
+	case 27: // n MA - Disk 1
+		c.MA[inst.Hi()] = src
+		c.event(EventDrumWrite, 0, int(inst.Hi()), src)
+	case 28: // n MB - Disk 2
+		c.MB[inst.Hi()] = src
+		c.event(EventDrumWrite, 1, int(inst.Hi()), src)
+	case 29: // n MC - Disk 3
+		c.MC[inst.Hi()] = src
+		c.event(EventDrumWrite, 2, int(inst.Hi()), src)
+	case 30: // n MD - Disk 4
+		c.MD[inst.Hi()] = src
+		c.event(EventDrumWrite, 3, int(inst.Hi()), src)
+	case 31: // T - Stop if non-zero
+		if src != 0 {
+			return ErrStop
+		}
+	default:
+		panic("k.Dest returned a number outside [0, 31]")
+	}
+	return nil
+}
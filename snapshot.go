@@ -0,0 +1,189 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package csirac
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Snapshot is a copy of the state of the machine: the registers, the
+// sign-bit option, the console switches, the instruction count, the stores,
+// and the input tape. The attached devices and the budget are not part of a
+// snapshot. Snapshots are saved as JSON.
+type Snapshot struct {
+	A, B, C, H Word
+	D          [16]Word
+	S, K, I    Word
+	Decimal    bool
+	SignAtP1   bool `json:",omitempty"`
+
+	NA, NB, IS, T Word
+
+	Instructions int64 `json:",omitempty"`
+
+	M              []Word
+	MA, MB, MC, MD []Word     `json:",omitempty"`
+	Input          *InputTape `json:",omitempty"`
+}
+
+// Snapshot returns a copy of the state of the machine.
+func (c *CSIRAC) Snapshot() *Snapshot {
+	s := &Snapshot{
+		A: c.A, B: c.B, C: c.C, H: c.H,
+		D: c.D,
+		S: c.S, K: c.K, I: c.I,
+		Decimal:  c.Decimal,
+		SignAtP1: c.SignAtP1,
+		NA:       c.NA, NB: c.NB, IS: c.IS, T: c.T,
+		Instructions: c.Instructions,
+		M:            copyWords(c.M),
+		MA:           copyWords(c.MA),
+		MB:           copyWords(c.MB),
+		MC:           copyWords(c.MC),
+		MD:           copyWords(c.MD),
+	}
+	if c.Input != nil {
+		s.Input = &InputTape{
+			Rows: append([]uint16(nil), c.Input.Rows...),
+			Pos:  c.Input.Pos,
+		}
+	}
+	return s
+}
+
+// Restore copies the state in the snapshot into the machine. The stores
+// are replaced with copies, so the snapshot can be restored again later.
+// The attached devices and the budget are left alone.
+func (s *Snapshot) Restore(c *CSIRAC) {
+	c.A, c.B, c.C, c.H = s.A, s.B, s.C, s.H
+	c.D = s.D
+	c.S, c.K, c.I = s.S, s.K, s.I
+	c.Decimal = s.Decimal
+	c.SignAtP1 = s.SignAtP1
+	c.NA, c.NB, c.IS, c.T = s.NA, s.NB, s.IS, s.T
+	c.Instructions = s.Instructions
+	c.M = copyWords(s.M)
+	c.MA = copyWords(s.MA)
+	c.MB = copyWords(s.MB)
+	c.MC = copyWords(s.MC)
+	c.MD = copyWords(s.MD)
+	c.Input = nil
+	if s.Input != nil {
+		c.Input = &InputTape{
+			Rows: append([]uint16(nil), s.Input.Rows...),
+			Pos:  s.Input.Pos,
+		}
+	}
+}
+
+// Clone returns a copy of the machine, with its own copies of the stores and
+// the input tape. No devices are attached to the copy.
+func (c *CSIRAC) Clone() *CSIRAC {
+	d := *c
+	d.devices, d.deviceErr = nil, nil
+	d.M = copyWords(c.M)
+	d.MA = copyWords(c.MA)
+	d.MB = copyWords(c.MB)
+	d.MC = copyWords(c.MC)
+	d.MD = copyWords(c.MD)
+	if c.Input != nil {
+		d.Input = &InputTape{
+			Rows: append([]uint16(nil), c.Input.Rows...),
+			Pos:  c.Input.Pos,
+		}
+	}
+	return &d
+}
+
+// WriteSnapshot writes the snapshot as JSON.
+func WriteSnapshot(w io.Writer, s *Snapshot) error {
+	return json.NewEncoder(w).Encode(s)
+}
+
+// ReadSnapshot reads a snapshot written by WriteSnapshot, and checks that
+// it describes a machine that could exist.
+func ReadSnapshot(r io.Reader) (*Snapshot, error) {
+	s := new(Snapshot)
+	if err := json.NewDecoder(r).Decode(s); err != nil {
+		return nil, err
+	}
+	if err := s.check(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// check validates the snapshot.
+func (s *Snapshot) check() error {
+	type reg struct {
+		name string
+		w    Word
+		mask Word
+	}
+	regs := []reg{
+		{"A", s.A, allBits}, {"B", s.B, allBits}, {"C", s.C, allBits},
+		{"H", s.H, lo10}, {"S", s.S, allBits}, {"K", s.K, allBits},
+		{"I", s.I, allBits}, {"NA", s.NA, allBits}, {"NB", s.NB, allBits},
+		{"IS", s.IS, allBits}, {"T", s.T, allBits},
+	}
+	for i, d := range s.D {
+		regs = append(regs, reg{fmt.Sprintf("D%d", i), d, allBits})
+	}
+	for _, r := range regs {
+		if r.w&^r.mask != 0 {
+			return fmt.Errorf("register %s: value %#x out of range", r.name, uint32(r.w))
+		}
+	}
+	if s.Instructions < 0 {
+		return fmt.Errorf("instruction count %d is negative", s.Instructions)
+	}
+	stores := []struct {
+		name string
+		m    []Word
+	}{{"M", s.M}, {"MA", s.MA}, {"MB", s.MB}, {"MC", s.MC}, {"MD", s.MD}}
+	for _, st := range stores {
+		if len(st.m) > 1024 {
+			return fmt.Errorf("store %s: %d words is more than 1024", st.name, len(st.m))
+		}
+		for i, w := range st.m {
+			if w&^allBits != 0 {
+				return fmt.Errorf("store %s cell %d: value %#x exceeds 20 bits", st.name, i, uint32(w))
+			}
+		}
+	}
+	if t := s.Input; t != nil {
+		if t.Pos < 0 || t.Pos > len(t.Rows) {
+			return fmt.Errorf("input tape position %d is outside the tape (%d rows)", t.Pos, len(t.Rows))
+		}
+		for i, row := range t.Rows {
+			if row&^0xfff != 0 {
+				return fmt.Errorf("input tape row %d: holes beyond 12 punched (%#04x)", i, row)
+			}
+		}
+	}
+	return nil
+}
+
+// copyWords returns a copy of m (nil if m is nil).
+func copyWords(m []Word) []Word {
+	if m == nil {
+		return nil
+	}
+	return append([]Word(nil), m...)
+}
@@ -0,0 +1,116 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package csirac
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	c := &CSIRAC{
+		A: 1, B: 2, C: 3, H: 4,
+		S: P(11), K: MustParseInstruction("0 0 A B"),
+		Decimal: true,
+		NA:      5, NB: 6, IS: 7, T: 8,
+		M:     []Word{MustParseInstruction("0 0 A B"), MustParseInstruction("0 0 A B")},
+		MB:    []Word{9, 10},
+		Input: &InputTape{Rows: []uint16{1, 2, 3}, Pos: 1},
+	}
+	c.D[15] = allBits
+	s := c.Snapshot()
+
+	// Changing the machine doesn't change the snapshot.
+	c.M[0], c.MB[0], c.Input.Rows[0], c.Input.Pos = 0, 0, 0, 3
+
+	var buf bytes.Buffer
+	if err := WriteSnapshot(&buf, s); err != nil {
+		t.Fatalf("WriteSnapshot() = %v", err)
+	}
+	got, err := ReadSnapshot(&buf)
+	if err != nil {
+		t.Fatalf("ReadSnapshot() = %v", err)
+	}
+	if !reflect.DeepEqual(got, s) {
+		t.Errorf("ReadSnapshot() = %+v, want %+v", got, s)
+	}
+
+	var d CSIRAC
+	got.Restore(&d)
+	if d.M[0] != MustParseInstruction("0 0 A B") || d.MB[0] != 9 || d.Input.Rows[0] != 1 || d.Input.Pos != 1 {
+		t.Errorf("after Restore: M[0], MB[0], Input = %v, %v, %+v; want snapshot values", d.M[0], d.MB[0], d.Input)
+	}
+	if d.MA != nil {
+		t.Errorf("after Restore: MA = %v, want nil", d.MA)
+	}
+	if !reflect.DeepEqual(d.Snapshot(), s) {
+		t.Errorf("Snapshot() after Restore = %+v, want %+v", d.Snapshot(), s)
+	}
+
+	// Restoring doesn't share stores with the snapshot.
+	d.M[1] = 0
+	if s.M[1] == 0 {
+		t.Error("changing the store after Restore changed the snapshot")
+	}
+}
+
+func TestReadSnapshotErrors(t *testing.T) {
+	tests := []string{
+		`{`,
+		`{"A": 1048576}`,
+		`{"H": 1024}`,
+		`{"D": [0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2000000]}`,
+		`{"M": [1048576]}`,
+		`{"MD": [0, 1048576]}`,
+		`{"Input": {"Rows": [1, 2], "Pos": 3}}`,
+		`{"Input": {"Rows": [4096]}}`,
+	}
+	for _, test := range tests {
+		if _, err := ReadSnapshot(strings.NewReader(test)); err == nil {
+			t.Errorf("ReadSnapshot(%s) = nil error, want error", test)
+		}
+	}
+	if _, err := ReadSnapshot(strings.NewReader(`{"M": [` + strings.Repeat("0, ", 1024) + `0]}`)); err == nil {
+		t.Error("ReadSnapshot(1025 words) = nil error, want error")
+	}
+}
+
+func TestClone(t *testing.T) {
+	c := &CSIRAC{
+		A:     1,
+		M:     []Word{1, 2},
+		MC:    []Word{3},
+		Input: &InputTape{Rows: []uint16{4, 5}, Pos: 1},
+	}
+	c.Attach(new(Teleprinter))
+	d := c.Clone()
+	if !reflect.DeepEqual(d.Snapshot(), c.Snapshot()) {
+		t.Errorf("Clone().Snapshot() = %+v, want %+v", d.Snapshot(), c.Snapshot())
+	}
+	d.A, d.M[0], d.MC[0], d.Input.Rows[0], d.Input.Pos = 0, 0, 0, 0, 2
+	if c.A != 1 || c.M[0] != 1 || c.MC[0] != 3 || c.Input.Rows[0] != 4 || c.Input.Pos != 1 {
+		t.Errorf("changing the clone changed the original: %+v", c.Snapshot())
+	}
+	if d.MA != nil || d.Input == c.Input {
+		t.Errorf("Clone() = %+v, want nil MA and a new input tape", d)
+	}
+	if len(d.devices) != 0 {
+		t.Errorf("Clone() has %d devices attached, want 0", len(d.devices))
+	}
+}
@@ -0,0 +1,7 @@
+; crlf prints a carriage return and a line feed on the teleprinter.
+	.export crlf, crlf_x
+crlf:	8  K  HU     ; H = carriage return
+	0  HL OT
+	2  K  HU     ; H = line feed
+	0  HL OT
+crlf_x:	0  Z  Z      ; return
@@ -0,0 +1,67 @@
+; div divides D15 by D14. The quotient (rounded towards zero) is returned in
+; A, and the remainder (with the same sign as D15) in B. Dividing by zero
+; returns a quotient of 0 and a remainder of D15. D14 must not be -524288.
+	.export div, div_x
+div:	15 D  A      ; A = x
+	13 A  D      ; D13 = x (its sign is the sign of the remainder)
+	14 D  NA     ; A = x ^ y
+	0  Z  HL     ; H = 0
+	0  SA CS     ; if A < 0 { skip next }
+	0  PE PS     ; skip next
+	0  PL HL     ; H = 1 (the quotient is negative)
+	14 D  A      ; A = y
+	0  SA CS     ; if A < 0 { skip next }
+	0  PE PS     ; skip next
+	0  TA SA     ; A = -A
+	14 A  D      ; d = |y|
+	0  ZA CS     ; if A != 0 { skip next }
+	div_z K S    ; goto div_z
+	15 D  A      ; A = x
+	0  SA CS     ; if A < 0 { skip next }
+	0  PE PS     ; skip next
+	0  TA SA     ; A = -A
+	15 A  D      ; n = |x|
+	12 Z  D      ; r = 0
+	0  Z  B      ; q = 0
+	20 K  C      ; C = 20 (in upper half)
+div_l:	12 D  A      ; A = r
+	0  TA A      ; A = 2r
+	15 SD CS     ; if top digit of n is 1 { skip next }
+	0  PE PS     ; skip next
+	0  PL PA     ; A++
+	12 A  D      ; r = A
+	15 D  A      ; A = n
+	0  TA A      ; A = 2n
+	15 A  D      ; n = A
+	0  B  A      ; A = q
+	0  TA A      ; A = 2q
+	0  A  B      ; q = A
+	12 D  A      ; A = r
+	14 D  SA     ; A = r - d
+	0  SA CS     ; if A < 0 { skip next }
+	0  PE PS     ; skip next
+	div_n K S    ; goto div_n
+	12 A  D      ; r -= d
+	0  B  A      ; A = q
+	0  PL PA     ; A++
+	0  A  B      ; q = A
+div_n:	0  PE SC     ; C--
+	0  C  A      ; A = C
+	0  ZA CS     ; if A != 0 { skip next }
+	0  PE PS     ; skip next
+	div_l K S    ; goto div_l
+	0  B  A      ; A = q
+	0  HL CS     ; if quotient negative { skip next }
+	0  PE PS     ; skip next
+	0  TA SA     ; A = -A
+	0  A  C      ; C = quotient
+	12 D  A      ; A = r
+	13 SD CS     ; if x negative { skip next }
+	0  PE PS     ; skip next
+	0  TA SA     ; A = -A
+	0  A  B      ; B = remainder
+	0  C  A      ; A = quotient
+div_x:	0  Z  Z      ; return
+div_z:	15 D  B      ; B = x
+	0  Z  A      ; A = 0
+	div_x K S    ; goto div_x
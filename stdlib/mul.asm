@@ -0,0 +1,22 @@
+; mul multiplies the integers D15 and D14, and returns the product in A. The
+; product must fit in 19 bits. XB multiplies the parts of its operands below
+; the sign digit, so mul multiplies the magnitudes and then fixes the sign.
+	.export mul, mul_x
+mul:	15 D  A      ; A = x
+	14 D  NA     ; A ^= y
+	12 A  D      ; D12 = sign of product
+	15 D  A      ; A = x
+	0  SA CS     ; if A < 0 { skip next }
+	0  PE PS     ; skip next
+	0  TA SA     ; A = -A
+	0  A  C      ; C = |x|
+	14 D  A      ; A = y
+	0  SA CS     ; if A < 0 { skip next }
+	0  PE PS     ; skip next
+	0  TA SA     ; A = -A
+	0  CA XB     ; B = |y|, A = upper part of |x|*|y|
+	0  RB A      ; A = lower part of |x|*|y|
+	12 SD CS     ; if product negative { skip next }
+	0  PE PS     ; skip next
+	0  TA SA     ; A = -A
+mul_x:	0  Z  Z      ; return
@@ -0,0 +1,21 @@
+; mulf multiplies D15 by D14 where at least one of them is a fraction, and
+; returns the upper part of the product in A: the product of two fractions,
+; or the integer part of the product of an integer and a fraction.
+	.export mulf, mulf_x
+mulf:	15 D  A      ; A = x
+	14 D  NA     ; A ^= y
+	12 A  D      ; D12 = sign of product
+	15 D  A      ; A = x
+	0  SA CS     ; if A < 0 { skip next }
+	0  PE PS     ; skip next
+	0  TA SA     ; A = -A
+	0  A  C      ; C = |x|
+	14 D  A      ; A = y
+	0  SA CS     ; if A < 0 { skip next }
+	0  PE PS     ; skip next
+	0  TA SA     ; A = -A
+	0  CA XB     ; B = |y|, A = upper part of |x|*|y|
+	12 SD CS     ; if product negative { skip next }
+	0  PE PS     ; skip next
+	0  TA SA     ; A = -A
+mulf_x:	0  Z  Z      ; return
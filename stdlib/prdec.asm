@@ -0,0 +1,47 @@
+; prdec prints D15 on the teleprinter as a signed decimal integer, in
+; figures shift.
+	.export prdec, prdec_x
+prdec:	12 Z  D      ; D12 = 0 (nothing printed yet)
+	27 K  HU     ; H = figures shift
+	0  HL OT
+	15 SD CS     ; if n < 0 { skip next }
+	0  PE PS     ; skip next
+	prdec_m M OT ; print "-"
+	15 D  A      ; A = n
+	0  SA CS     ; if A < 0 { skip next }
+	0  PE PS     ; skip next
+	0  TA SA     ; A = -A
+	15 A  D      ; n = |n|
+	0  Z  C      ; C = 0 (index into prdec_p)
+prdec_d:	0  C  PK     ; next command += C
+	prdec_p M A  ; A = power of ten
+	14 A  D      ; p = A
+	13 Z  D      ; D13 = 0 (digit, in upper half)
+prdec_s:	15 D  A      ; A = n
+	14 D  SA     ; A = n - p
+	0  SA CS     ; if A < 0 { skip next }
+	0  PE PS     ; skip next
+	prdec_o K S  ; goto prdec_o
+	15 A  D      ; n -= p
+	13 PE PD     ; digit++
+	prdec_s K S  ; goto prdec_s
+prdec_o:	13 D  A      ; A = digit
+	12 D  PA     ; A += printed
+	0  ZA CS     ; if A != 0 { skip next }
+	prdec_n K S  ; goto prdec_n
+	13 D  PK     ; next command += digit
+	prdec_c M OT ; print digit
+	12 PL D      ; printed = 1
+prdec_n:	0  PE PC     ; C++
+	0  C  A      ; A = C
+	5  K  SA     ; A -= 5
+	0  ZA CS     ; if A != 0 { skip next }
+	prdec_f K S  ; goto prdec_f
+	prdec_d K S  ; goto prdec_d
+prdec_f:	15 D  HL     ; H = n (the last digit)
+	0  HU PK     ; next command += H
+	prdec_c M OT ; print digit
+prdec_x:	0  Z  Z      ; return
+prdec_m:	.word 3      ; "-"
+prdec_p:	.word 100000, 10000, 1000, 100, 10
+prdec_c:	.word 22, 23, 19, 1, 10, 16, 21, 7, 6, 24 ; "0" to "9"
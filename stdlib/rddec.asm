@@ -0,0 +1,30 @@
+; rddec reads a signed decimal integer from the input tape into A. The number
+; is punched as a row for each digit (see csirac.DecimalRow), followed by a
+; row with hole 11 punched. Hole 12 is also punched in that row if the number
+; is negative. The input is left in binary mode.
+	.export rddec, rddec_x
+rddec:	0  PL Q      ; decimal input
+	0  Z  C      ; C = 0
+rddec_l:	0  I  A      ; A = next digit
+	0  A  HU     ; H = control holes
+	0  HL CS     ; if H != 0 { skip next }
+	0  PE PS     ; skip next
+	rddec_e K S  ; goto rddec_e
+	15 A  D      ; D15 = digit
+	0  C  A      ; A = C
+	0  TA A      ; A = 2C
+	14 A  D      ; D14 = 2C
+	0  TA A      ; A = 4C
+	0  TA A      ; A = 8C
+	14 D  PA     ; A = 10C
+	15 D  PA     ; A += digit
+	0  A  C      ; C = A
+	rddec_l K S  ; goto rddec_l
+rddec_e:	0  HL A      ; A = control holes
+	0  Z  Q      ; binary input
+	14 HA D      ; D14 = hole 12
+	0  C  A      ; A = C
+	14 D  CS     ; if negative { skip next }
+	0  PE PS     ; skip next
+	0  TA SA     ; A = -A
+rddec_x:	0  Z  Z      ; return
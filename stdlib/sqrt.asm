@@ -0,0 +1,30 @@
+; sqrt returns the integer square root of D15 (rounded down) in A. D15 must
+; not be negative.
+	.export sqrt, sqrt_x
+sqrt:	15 D  A      ; A = x
+	12 A  D      ; n = x
+	13 Z  D      ; r = 0
+	256 K A      ; A = 2^18
+	14 A  D      ; b = 2^18
+sqrt_l:	13 D  A      ; A = r
+	14 D  PA     ; A = r + b
+	15 A  D      ; D15 = r + b
+	12 D  A      ; A = n
+	15 D  SA     ; A = n - (r + b)
+	0  SA CS     ; if A < 0 { skip next }
+	0  PE PS     ; skip next
+	sqrt_s K S   ; goto sqrt_s
+	12 A  D      ; n -= r + b
+	13 RD D      ; r >>= 1
+	14 D  A      ; A = b
+	13 A  PD     ; r += b
+	sqrt_n K S   ; goto sqrt_n
+sqrt_s:	13 RD D      ; r >>= 1
+sqrt_n:	14 RD D      ; b >>= 2
+	14 RD D
+	14 D  A      ; A = b
+	0  ZA CS     ; if A != 0 { skip next }
+	0  PE PS     ; skip next
+	sqrt_l K S   ; goto sqrt_l
+	13 D  A      ; A = r
+sqrt_x:	0  Z  Z      ; return
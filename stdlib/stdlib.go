@@ -0,0 +1,121 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package stdlib is a library of subroutines written in CSIRAC assembler
+// (see csirac.Assemble). CSIRAC has a multiplier but no divider, and no
+// instructions for decimal conversion; programs relied on library routines
+// for these.
+//
+// The routines are:
+//
+//	mul    multiply integers D15 and D14; product in A
+//	mulf   multiply D15 and D14, at least one a fraction; product in A
+//	div    divide D15 by D14; quotient in A, remainder in B
+//	sqrt   integer square root of D15, in A
+//	prdec  print D15 on the teleprinter in decimal
+//	crlf   print a carriage return and line feed
+//	rddec  read a decimal number from the input tape into A
+//
+// All routines follow the same linkage convention. Arguments are passed in
+// D15 (and then D14), and results are returned in A (and then B). Routines
+// may change B, C, H and D12-D15, but preserve D0-D11 and the rest of the
+// store. Each routine ends with a cell (its name with the suffix _x) that the
+// caller replaces with a jump back to itself before jumping to the routine:
+// a "stored return jump" (see Call). For example, to call div:
+//
+//		r M   A      ; A = the return jump below
+//		div_x A M    ; store it at the end of div
+//		div K S      ; goto div
+//	r:	.+1 K S      ; div returns here, and jumps to the next command
+//
+// Labels used within a routine begin with the routine's name and an
+// underscore, so the source of a routine can be appended to a program without
+// clashing with the program's own labels. Alternatively, each routine can be
+// assembled as a separate object and linked with the program.
+package stdlib
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strings"
+
+	"github.com/DrJosh9000/CSIRAC"
+)
+
+//go:embed *.asm
+var sources embed.FS
+
+// Names returns the names of all the routines, sorted.
+func Names() []string {
+	ents, err := fs.Glob(sources, "*.asm")
+	if err != nil {
+		panic(err) // only possible if the pattern is malformed
+	}
+	names := make([]string, len(ents))
+	for i, e := range ents {
+		names[i] = strings.TrimSuffix(e, ".asm")
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Source returns the source of the named routines, concatenated, ready to be
+// appended to a program. Each routine is included once, even if named more
+// than once.
+func Source(names ...string) (string, error) {
+	var sb strings.Builder
+	seen := make(map[string]bool)
+	for _, n := range names {
+		if seen[n] {
+			continue
+		}
+		seen[n] = true
+		src, err := sources.ReadFile(n + ".asm")
+		if err != nil {
+			return "", fmt.Errorf("unknown routine %q", n)
+		}
+		sb.Write(src)
+	}
+	return sb.String(), nil
+}
+
+// Object assembles the named routine as a relocatable object (see package
+// link). The object exports the routine's name and its return cell.
+func Object(name string) (*csirac.Object, error) {
+	src, err := Source(name)
+	if err != nil {
+		return nil, err
+	}
+	return csirac.AssembleObject(strings.NewReader(src))
+}
+
+// Call returns assembler source that calls the routine sub with a stored
+// return jump, using ret as the label of the return jump. Compilers use it
+// to call the library routines, and their own.
+func Call(sub, ret string) string {
+	return fmt.Sprintf("\t%s M A\n\t%s_x A M\n\t%s K S\n%s:\n\t.+1 K S\n", ret, sub, sub, ret)
+}
+
+// MustSource returns the source of the named routines, or panics.
+func MustSource(names ...string) string {
+	src, err := Source(names...)
+	if err != nil {
+		panic(err)
+	}
+	return src
+}
@@ -0,0 +1,262 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package stdlib
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/DrJosh9000/CSIRAC"
+)
+
+// driver calls the routine sub with arguments from cells x and y (in D15 and
+// D14), and stores the results (A and B) in cells a and b.
+const driver = `
+	x  M  A
+	15 A  D      ; D15 = x
+	y  M  A
+	14 A  D      ; D14 = y
+	r  M  A
+	SUB_x A M
+	SUB K S
+r:	.+1 K S
+	a  A  M
+	0  B  A
+	b  A  M
+	0  PL T      ; stop
+x:	.word 0
+y:	.word 0
+a:	.word 0
+b:	.word 0
+`
+
+// machine assembles a driver for the routine sub.
+func machine(t *testing.T, sub string, signAtP1 bool) (*csirac.CSIRAC, map[string]int) {
+	t.Helper()
+	src := strings.ReplaceAll(driver, "SUB", sub) + MustSource(sub) + "\t.word 0\n"
+	p, err := csirac.Assemble(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("Assemble() = %v\n%s", err, src)
+	}
+	c := &csirac.CSIRAC{M: make([]csirac.Word, 1024), SignAtP1: signAtP1}
+	copy(c.M, p.Words)
+	return c, p.Labels
+}
+
+// eachSign runs f for each reading of the sign-bit sources (see
+// csirac.CSIRAC.SignAtP1), since the routines should work with either.
+func eachSign(t *testing.T, f func(t *testing.T, signAtP1 bool)) {
+	for _, signAtP1 := range []bool{false, true} {
+		signAtP1 := signAtP1
+		t.Run(fmt.Sprintf("SignAtP1=%t", signAtP1), func(t *testing.T) { f(t, signAtP1) })
+	}
+}
+
+// call runs the machine from address 0 with arguments x and y, and returns A
+// and B.
+func call(t *testing.T, c *csirac.CSIRAC, labels map[string]int, x, y int) (int, int) {
+	t.Helper()
+	c.M[labels["x"]] = csirac.IntWord(x)
+	c.M[labels["y"]] = csirac.IntWord(y)
+	c.S = 0
+	c.K = c.M[0]
+	if err := c.Run(0, false); err != nil {
+		t.Fatalf("c.Run(0) = %v, want nil", err)
+	}
+	return c.M[labels["a"]].Int(), c.M[labels["b"]].Int()
+}
+
+func TestMul(t *testing.T) {
+	eachSign(t, func(t *testing.T, signAtP1 bool) {
+		c, labels := machine(t, "mul", signAtP1)
+		tests := [][2]int{
+			{0, 5}, {6, 7}, {-6, 7}, {6, -7}, {-6, -7}, {1, 524287}, {-1, 524287},
+			{724, 724}, {-724, 724}, {524287, -1},
+		}
+		rng := rand.New(rand.NewSource(1))
+		for i := 0; i < 200; i++ {
+			tests = append(tests, [2]int{rng.Intn(1<<10) - 1<<9, rng.Intn(1<<9) - 1<<8})
+		}
+		for _, test := range tests {
+			x, y := test[0], test[1]
+			if got, _ := call(t, c, labels, x, y); got != x*y {
+				t.Errorf("mul(%d, %d) = %d, want %d", x, y, got, x*y)
+			}
+		}
+	})
+}
+
+func TestMulf(t *testing.T) {
+	eachSign(t, func(t *testing.T, signAtP1 bool) {
+		c, labels := machine(t, "mulf", signAtP1)
+		// Fractions are in units of 2^-19, so the upper part of the product
+		// is x*y/2^19, rounded towards zero.
+		tests := [][2]int{
+			{0, 1 << 18}, {1 << 18, 1 << 18}, {-1 << 18, 1 << 18}, {1 << 18, -1 << 18},
+			{-1 << 18, -1 << 18}, {524287, 524287}, {100, 1 << 18}, {-100, 1 << 18},
+		}
+		rng := rand.New(rand.NewSource(1))
+		for i := 0; i < 200; i++ {
+			tests = append(tests, [2]int{rng.Intn(1<<20-1) - 1<<19 + 1, rng.Intn(1<<20-1) - 1<<19 + 1})
+		}
+		for _, test := range tests {
+			x, y := test[0], test[1]
+			want := x * y / (1 << 19)
+			if got, _ := call(t, c, labels, x, y); got != want {
+				t.Errorf("mulf(%d, %d) = %d, want %d", x, y, got, want)
+			}
+		}
+	})
+}
+
+func TestCrlf(t *testing.T) {
+	eachSign(t, func(t *testing.T, signAtP1 bool) {
+		c, labels := machine(t, "crlf", signAtP1)
+		tp := new(csirac.Teleprinter)
+		c.Attach(tp)
+		call(t, c, labels, 0, 0)
+		// The teleprinter doesn't show carriage returns.
+		if got, want := tp.String(), "\n"; got != want {
+			t.Errorf("crlf() printed %q, want %q", got, want)
+		}
+	})
+}
+
+func TestDiv(t *testing.T) {
+	eachSign(t, func(t *testing.T, signAtP1 bool) {
+		c, labels := machine(t, "div", signAtP1)
+		tests := [][2]int{
+			{0, 1}, {7, 2}, {-7, 2}, {7, -2}, {-7, -2}, {100, 7}, {6, 3},
+			{1, 524287}, {524287, 1}, {524287, 524287}, {-524288, 1},
+			{-524288, 3}, {-524288, 524287}, {12345, 0}, {-9, 0},
+		}
+		rng := rand.New(rand.NewSource(1))
+		for i := 0; i < 200; i++ {
+			tests = append(tests, [2]int{rng.Intn(1<<20) - 1<<19, rng.Intn(1<<20-1) - 1<<19 + 1})
+		}
+		for _, test := range tests {
+			x, y := test[0], test[1]
+			wantQ, wantR := 0, x
+			if y != 0 {
+				wantQ, wantR = x/y, x%y
+			}
+			gotQ, gotR := call(t, c, labels, x, y)
+			if gotQ != wantQ || gotR != wantR {
+				t.Errorf("div(%d, %d) = (%d, %d), want (%d, %d)", x, y, gotQ, gotR, wantQ, wantR)
+			}
+		}
+	})
+}
+
+func TestSqrt(t *testing.T) {
+	eachSign(t, func(t *testing.T, signAtP1 bool) {
+		c, labels := machine(t, "sqrt", signAtP1)
+		tests := []int{0, 1, 2, 3, 4, 15, 16, 17, 99, 100, 65535, 65536, 524287}
+		rng := rand.New(rand.NewSource(1))
+		for i := 0; i < 200; i++ {
+			tests = append(tests, rng.Intn(1<<19))
+		}
+		for _, x := range tests {
+			want := int(math.Sqrt(float64(x)))
+			if got, _ := call(t, c, labels, x, 0); got != want {
+				t.Errorf("sqrt(%d) = %d, want %d", x, got, want)
+			}
+		}
+	})
+}
+
+func TestPrdec(t *testing.T) {
+	eachSign(t, func(t *testing.T, signAtP1 bool) {
+		c, labels := machine(t, "prdec", signAtP1)
+		tests := []int{0, 1, -1, 9, 10, 42, -100, 99999, 100000, 524287, -524288}
+		rng := rand.New(rand.NewSource(1))
+		for i := 0; i < 100; i++ {
+			tests = append(tests, rng.Intn(1<<20)-1<<19)
+		}
+		for _, x := range tests {
+			tp := new(csirac.Teleprinter)
+			detach := c.Attach(tp)
+			call(t, c, labels, x, 0)
+			detach()
+			if got, want := tp.String(), strconv.Itoa(x); got != want {
+				t.Errorf("prdec(%d) printed %q, want %q", x, got, want)
+			}
+		}
+	})
+}
+
+func TestRddec(t *testing.T) {
+	eachSign(t, func(t *testing.T, signAtP1 bool) {
+		c, labels := machine(t, "rddec", signAtP1)
+		tests := []int{0, 7, -7, 42, 1000, -31337, 524287, -524288}
+		for _, x := range tests {
+			c.Input = new(csirac.InputTape)
+			end := uint16(1 << 10) // hole 11
+			if x < 0 {
+				end |= 1 << 11 // hole 12
+			}
+			for _, d := range strings.TrimPrefix(strconv.Itoa(x), "-") {
+				c.Input.Rows = append(c.Input.Rows, csirac.DecimalRow(int(d-'0')))
+			}
+			c.Input.Rows = append(c.Input.Rows, end)
+			if got, _ := call(t, c, labels, 0, 0); got != x {
+				t.Errorf("rddec() = %d, want %d", got, x)
+			}
+			if c.Decimal {
+				t.Errorf("after rddec(): c.Decimal = true, want false")
+			}
+		}
+	})
+}
+
+func TestPreservesRegisters(t *testing.T) {
+	eachSign(t, func(t *testing.T, signAtP1 bool) {
+		for _, sub := range Names() {
+			c, labels := machine(t, sub, signAtP1)
+			c.Input = &csirac.InputTape{Rows: []uint16{csirac.DecimalRow(3), 1 << 10}}
+			for i := 0; i < 12; i++ {
+				c.D[i] = csirac.Word(1000 + i)
+			}
+			want := c.D
+			call(t, c, labels, 1234, 56)
+			if got := c.D; !reflect.DeepEqual(got[:12], want[:12]) {
+				t.Errorf("%s: D0-D11 = %v, want %v", sub, got[:12], want[:12])
+			}
+		}
+	})
+}
+
+func TestSource(t *testing.T) {
+	if got, want := Names(), []string{"crlf", "div", "mul", "mulf", "prdec", "rddec", "sqrt"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Names() = %v, want %v", got, want)
+	}
+	src, err := Source("div", "sqrt", "div")
+	if err != nil {
+		t.Fatalf("Source() = %v", err)
+	}
+	if got := strings.Count(src, "\ndiv_x:"); got != 1 {
+		t.Errorf("Source(div, sqrt, div) includes div %d times, want 1", got)
+	}
+	if _, err := Source("nope"); err == nil {
+		t.Error("Source(nope) = nil error, want error")
+	}
+}
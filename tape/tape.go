@@ -0,0 +1,225 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package tape reads and writes images of CSIRAC paper tapes.
+//
+// CSIRAC read programs and data from 12-hole paper tape, and punched its
+// output on 5-hole teleprinter tape. Both kinds of tape can be stored in one of
+// two formats:
+//
+//   - Raw: one byte per row for 5-hole tape, or two bytes per row
+//     (little-endian) for 12-hole tape. Hole n is bit n-1.
+//   - Holes: an ASCII-art rendering with one line per row, suitable for
+//     transcribing scanned tapes by hand. Punched holes are drawn as 'o',
+//     unpunched positions as ' ', and the sprocket hole as '.'. Blank lines
+//     and lines beginning with '#' are ignored when reading.
+//
+// A 5-hole row is drawn "|12.345|" (the sprocket hole lies between holes 2 and
+// 3). A 12-hole row is drawn "|1234567890.ab|", where a and b are holes 11 and
+// 12 (here the sprocket hole separates the ten data holes from the two
+// control holes).
+package tape
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/DrJosh9000/CSIRAC"
+)
+
+// Format is a tape image format.
+type Format int
+
+// Tape image formats.
+const (
+	Raw Format = iota
+	Holes
+)
+
+func (f Format) String() string {
+	switch f {
+	case Raw:
+		return "raw"
+	case Holes:
+		return "holes"
+	}
+	return fmt.Sprintf("Format(%d)", int(f))
+}
+
+// Positions of holes 1-5 and 1-12 within a rendered row.
+var (
+	holePos5  = []int{1, 2, 4, 5, 6}
+	holePos12 = []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 12, 13}
+)
+
+const (
+	blankRow5  = "|  .   |"
+	blankRow12 = "|          .  |"
+)
+
+// Input is a 12-hole input tape. Hole n of each row is bit n-1.
+type Input []uint16
+
+// Output is a 5-hole output tape. Hole n of each row is bit n-1.
+type Output []byte
+
+// ReadInput reads a 12-hole tape image.
+func ReadInput(r io.Reader, f Format) (Input, error) {
+	switch f {
+	case Raw:
+		t, err := csirac.ReadInputTape(r)
+		if err != nil {
+			return nil, err
+		}
+		return t.Rows, nil
+	case Holes:
+		var t Input
+		err := readHoles(r, blankRow12, holePos12, func(row uint16) {
+			t = append(t, row)
+		})
+		return t, err
+	}
+	return nil, fmt.Errorf("unsupported format %v", f)
+}
+
+// Write writes the tape image in the given format.
+func (t Input) Write(w io.Writer, f Format) error {
+	switch f {
+	case Raw:
+		return t.Load().WriteRaw(w)
+	case Holes:
+		bw := bufio.NewWriter(w)
+		for _, row := range t {
+			bw.WriteString(renderRow(row, blankRow12, holePos12))
+		}
+		return bw.Flush()
+	}
+	return fmt.Errorf("unsupported format %v", f)
+}
+
+// Load returns the tape ready to be loaded into the tape reader of a machine.
+func (t Input) Load() *csirac.InputTape {
+	return &csirac.InputTape{Rows: t}
+}
+
+// ReadOutput reads a 5-hole tape image.
+func ReadOutput(r io.Reader, f Format) (Output, error) {
+	switch f {
+	case Raw:
+		b, err := io.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		for i, row := range b {
+			if row&^0x1f != 0 {
+				return nil, fmt.Errorf("row %d: holes beyond 5 punched (%#02x)", i, row)
+			}
+		}
+		return b, nil
+	case Holes:
+		var t Output
+		err := readHoles(r, blankRow5, holePos5, func(row uint16) {
+			t = append(t, byte(row))
+		})
+		return t, err
+	}
+	return nil, fmt.Errorf("unsupported format %v", f)
+}
+
+// Write writes the tape image in the given format.
+func (t Output) Write(w io.Writer, f Format) error {
+	p := &csirac.Punch{Rows: t}
+	switch f {
+	case Raw:
+		return p.WriteRaw(w)
+	case Holes:
+		return p.WriteHoles(w)
+	}
+	return fmt.Errorf("unsupported format %v", f)
+}
+
+// Input converts the output tape into an input tape, the way an output tape
+// could be fed into the tape reader. Holes 1-5 become holes 1-5.
+func (t Output) Input() Input {
+	in := make(Input, len(t))
+	for i, row := range t {
+		in[i] = uint16(row)
+	}
+	return in
+}
+
+// FromWords encodes words onto 12-hole tape, two rows per word (see
+// csirac.WordsToRows).
+func FromWords(ws []csirac.Word) Input {
+	return csirac.WordsToRows(ws)
+}
+
+// Words decodes words encoded onto tape by FromWords.
+func (t Input) Words() ([]csirac.Word, error) {
+	if len(t)%2 != 0 {
+		return nil, fmt.Errorf("tape has odd number of rows %d", len(t))
+	}
+	ws := make([]csirac.Word, len(t)/2)
+	for i := range ws {
+		hi, lo := t[2*i], t[2*i+1]
+		if (hi|lo)&^0x3ff != 0 {
+			return nil, fmt.Errorf("word %d: control holes punched", i)
+		}
+		ws[i] = csirac.Word(hi)<<10 | csirac.Word(lo)
+	}
+	return ws, nil
+}
+
+func renderRow(row uint16, blank string, pos []int) string {
+	line := []byte(blank + "\n")
+	for n, i := range pos {
+		if row&(1<<n) != 0 {
+			line[i] = 'o'
+		}
+	}
+	return string(line)
+}
+
+func readHoles(r io.Reader, blank string, pos []int, emit func(uint16)) error {
+	lc := 0
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		lc++
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if len(line) != len(blank) {
+			return fmt.Errorf("line %d: row %q has length %d, want %d", lc, line, len(line), len(blank))
+		}
+		var row uint16
+		for i := range blank {
+			// Only unpunched positions may differ from a blank row.
+			if c := line[i]; c != blank[i] && (c != 'o' || blank[i] != ' ') {
+				return fmt.Errorf("line %d: invalid character %q at column %d", lc, c, i+1)
+			}
+		}
+		for n, i := range pos {
+			if line[i] == 'o' {
+				row |= 1 << n
+			}
+		}
+		emit(row)
+	}
+	return sc.Err()
+}
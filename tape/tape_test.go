@@ -0,0 +1,147 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package tape
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/DrJosh9000/CSIRAC"
+)
+
+func TestInputRoundTrip(t *testing.T) {
+	in := Input{0, 1, 0x3ff, 0x400, 0x800, 0xfff, 0x155}
+	for _, f := range []Format{Raw, Holes} {
+		var buf bytes.Buffer
+		if err := in.Write(&buf, f); err != nil {
+			t.Fatalf("in.Write(%v) = %v", f, err)
+		}
+		got, err := ReadInput(&buf, f)
+		if err != nil {
+			t.Fatalf("ReadInput(%v) = %v", f, err)
+		}
+		if !reflect.DeepEqual(got, in) {
+			t.Errorf("ReadInput(%v) = %v, want %v", f, got, in)
+		}
+	}
+}
+
+func TestOutputRoundTrip(t *testing.T) {
+	out := Output{0, 1, 2, 4, 8, 16, 31, 19}
+	for _, f := range []Format{Raw, Holes} {
+		var buf bytes.Buffer
+		if err := out.Write(&buf, f); err != nil {
+			t.Fatalf("out.Write(%v) = %v", f, err)
+		}
+		got, err := ReadOutput(&buf, f)
+		if err != nil {
+			t.Fatalf("ReadOutput(%v) = %v", f, err)
+		}
+		if !reflect.DeepEqual(got, out) {
+			t.Errorf("ReadOutput(%v) = %v, want %v", f, got, out)
+		}
+	}
+}
+
+func TestReadHoles(t *testing.T) {
+	src := `
+		# A transcribed tape.
+		|o         .  |
+		|          . o|
+
+		|oo o      .o |
+	`
+	got, err := ReadInput(strings.NewReader(src), Holes)
+	if err != nil {
+		t.Fatalf("ReadInput(Holes) = %v", err)
+	}
+	if want := (Input{1, 0x800, 0x40b}); !reflect.DeepEqual(got, want) {
+		t.Errorf("ReadInput(Holes) = %#v, want %#v", got, want)
+	}
+
+	for _, bad := range []string{
+		"|o .  |",    // too short
+		"|o x   |",   // invalid character
+		"|o o   |",   // sprocket hole replaced
+		"|o .   | x", // trailing junk
+	} {
+		if _, err := ReadOutput(strings.NewReader(bad), Holes); err == nil {
+			t.Errorf("ReadOutput(%q, Holes) = nil error, want error", bad)
+		}
+	}
+}
+
+func TestWordsRoundTrip(t *testing.T) {
+	program := csirac.MustParseProgram(`
+		 0  0 A  SA
+		 0  8 K  C
+		31 27 K  PS
+		 0  0 PL T
+	`)
+	in := FromWords(program)
+	if got, want := len(in), 2*len(program); got != want {
+		t.Errorf("len(FromWords(program)) = %d, want %d", got, want)
+	}
+
+	var buf bytes.Buffer
+	if err := in.Write(&buf, Holes); err != nil {
+		t.Fatalf("in.Write(Holes) = %v", err)
+	}
+	in2, err := ReadInput(&buf, Holes)
+	if err != nil {
+		t.Fatalf("ReadInput(Holes) = %v", err)
+	}
+	got, err := in2.Words()
+	if err != nil {
+		t.Fatalf("in.Words() = %v", err)
+	}
+	if !reflect.DeepEqual(got, program) {
+		t.Errorf("in.Words() = %v, want %v", got, program)
+	}
+
+	if _, err := (Input{1, 2, 3}).Words(); err == nil {
+		t.Error("Input{1, 2, 3}.Words() = nil error, want error")
+	}
+	if _, err := (Input{0x800, 0}).Words(); err == nil {
+		t.Error("Input{0x800, 0}.Words() = nil error, want error")
+	}
+}
+
+func TestOutputAsInput(t *testing.T) {
+	out := Output{1, 19, 0}
+	c := &csirac.CSIRAC{
+		M: []csirac.Word{
+			csirac.MustParseInstruction(" 0  0 I  D"),
+			csirac.MustParseInstruction(" 0  1 I  D"),
+			csirac.MustParseInstruction(" 0  0 PL T"),
+			0,
+		},
+		Input: out.Input().Load(),
+	}
+	c.K = c.M[0]
+	if err := c.Run(0, false); err != nil {
+		t.Fatalf("c.Run(0) = %v, want nil", err)
+	}
+	if got, want := c.D[0], csirac.Word(1); got != want {
+		t.Errorf("after Run: c.D[0] = %d, want %d", got, want)
+	}
+	if got, want := c.D[1], csirac.Word(19); got != want {
+		t.Errorf("after Run: c.D[1] = %d, want %d", got, want)
+	}
+}
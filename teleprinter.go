@@ -0,0 +1,126 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package csirac
+
+import "strings"
+
+// Teleprinter shift codes.
+const (
+	CodeFigures = 0b11011
+	CodeLetters = 0b11111
+)
+
+// The teleprinter uses 5-hole code. Here it is assumed to be ITA2, with hole
+// 1 as the least significant digit. Zero runes are codes that don't print.
+var (
+	letterCodes = [32]rune{
+		1: 'E', 2: '\n', 3: 'A', 4: ' ', 5: 'S', 6: 'I', 7: 'U',
+		8: '\r', 9: 'D', 10: 'R', 11: 'J', 12: 'N', 13: 'F', 14: 'C', 15: 'K',
+		16: 'T', 17: 'Z', 18: 'L', 19: 'W', 20: 'H', 21: 'Y', 22: 'P', 23: 'Q',
+		24: 'O', 25: 'B', 26: 'G', 28: 'M', 29: 'X', 30: 'V',
+	}
+	figureCodes = [32]rune{
+		1: '3', 2: '\n', 3: '-', 4: ' ', 5: '\'', 6: '8', 7: '7',
+		8: '\r', 10: '4', 12: ',', 14: ':', 15: '(',
+		16: '5', 17: '+', 18: ')', 19: '2', 21: '6', 22: '0', 23: '1',
+		24: '9', 25: '?', 28: '.', 29: '/', 30: '=',
+	}
+)
+
+// Teleprinter decodes words sent to the teleprinter into text. It is a
+// Device; to attach it to a machine, call c.Attach(t).
+type Teleprinter struct {
+	figures bool
+	text    strings.Builder
+}
+
+// Print prints the character for digits 1-5 of w. Carriage returns are
+// dropped; line feeds start a new line.
+func (t *Teleprinter) Print(w Word) {
+	code := w & 0x1f
+	switch code {
+	case CodeFigures:
+		t.figures = true
+		return
+	case CodeLetters:
+		t.figures = false
+		return
+	}
+	r := letterCodes[code]
+	if t.figures {
+		r = figureCodes[code]
+	}
+	if r == 0 || r == '\r' {
+		return
+	}
+	t.text.WriteRune(r)
+}
+
+// Event prints the word in each EventPrint.
+func (t *Teleprinter) Event(c *CSIRAC, e Event) error {
+	if e.Kind == EventPrint {
+		t.Print(e.Word)
+	}
+	return nil
+}
+
+// String returns all the text printed so far.
+func (t *Teleprinter) String() string { return t.text.String() }
+
+// EncodeText encodes s as teleprinter codes, inserting shift codes where
+// needed. It starts with the teleprinter in letters shift. Each newline is
+// encoded as a carriage return and line feed. Characters that can't be
+// printed are skipped.
+func EncodeText(s string) []Word {
+	var ws []Word
+	figures := false
+	for _, r := range strings.ToUpper(s) {
+		if r == '\n' {
+			ws = append(ws, 8, 2)
+			continue
+		}
+		code, fig, ok := encodeRune(r)
+		if !ok {
+			continue
+		}
+		if code != 4 && fig != figures {
+			figures = fig
+			if fig {
+				ws = append(ws, CodeFigures)
+			} else {
+				ws = append(ws, CodeLetters)
+			}
+		}
+		ws = append(ws, code)
+	}
+	return ws
+}
+
+// encodeRune returns the code for r, and whether it is in figures shift.
+func encodeRune(r rune) (code Word, figures, ok bool) {
+	for i, l := range letterCodes {
+		if l == r && r != 0 {
+			return Word(i), false, true
+		}
+	}
+	for i, f := range figureCodes {
+		if f == r && r != 0 {
+			return Word(i), true, true
+		}
+	}
+	return 0, false, false
+}
@@ -0,0 +1,41 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package csirac
+
+import "testing"
+
+func TestTeleprinterEncodeText(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"", ""},
+		{"HELLO WORLD", "HELLO WORLD"},
+		{"csirac 1949\n", "CSIRAC 1949\n"},
+		{"3.14 - 2 = 1.14?", "3.14 - 2 = 1.14?"},
+		{"A1B2 C3", "A1B2 C3"},
+		{"no {braces}", "NO BRACES"},
+	}
+	for _, test := range tests {
+		var tp Teleprinter
+		for _, w := range EncodeText(test.in) {
+			tp.Print(w)
+		}
+		if got := tp.String(); got != test.want {
+			t.Errorf("printing EncodeText(%q) = %q, want %q", test.in, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+; Destinations A, PA, SA, CA, DA and NA write, add, subtract, AND, OR and
+; XOR into the A register. Sums and differences wrap around at 20 digits.
+;
+; want: D1=8 D2=10 D3=12 D4=0 A=-1
+	x M  A		; A = 0b1100
+	y M  CA		; A &= 0b1010
+	1 A  D
+	y M  DA		; A |= 0b1010
+	2 A  D
+	z M  NA		; A ^= 0b0110
+	3 A  D
+	m1 M A		; A = -1
+	PL PA		; A += 1, wrapping to 0
+	4 A  D
+	PL SA		; A -= 1, wrapping to -1
+	1023 K T	; stop
+	.word 0
+x:	.word 0b1100
+y:	.word 0b1010
+z:	.word 0b0110
+m1:	.word (31,31,31,31)
@@ -0,0 +1,19 @@
+; Destinations C, PC and SC write, add and subtract into the C register, and
+; D, PD and SD into a D register. Only the lowest four digits of the address
+; choose the D register, so 19 PD adds into D3.
+;
+; want: C=-2 D1=8 D2=-2 D3=-4
+	five M C	; C = 5
+	three M PC	; C += 3
+	1 C  D
+	ten M SC	; C -= 10
+	2 C  D
+	3 C  D		; D3 = C
+	3 C  PD		; D3 += C
+	3 PL SD		; D3 -= 1
+	19 PL PD	; D3 += 1
+	1023 K T	; stop
+	.word 0
+three:	.word 3
+five:	.word 5
+ten:	.word 10
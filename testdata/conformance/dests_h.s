@@ -0,0 +1,11 @@
+; Destinations HL and HU write the lower or upper half of the entering
+; number into the H register.
+;
+; want: H=34 D1=100 D2=(1,2,0,0)
+	w M  HL		; H = lower half of w
+	1 HL D
+	w M  HU		; H = upper half of w
+	2 HU D
+	1023 K T	; stop
+	.word 0
+w:	.word (1,2,3,4)
@@ -0,0 +1,16 @@
+; Sources and destinations MA, MB, MC and MD read and write the drums.
+;
+; setup: MA[3]=1 MB[3]=2 MC[3]=4 MD[3]=8
+; want: MA[7]=12 MB[8]=12 MC[9]=12 MD[10]=12 C=15
+	v M  A		; A = 12
+	7 A  MA
+	8 A  MB
+	9 A  MC
+	10 A MD
+	3 MA C		; C = MA[3]
+	3 MB PC		; C += MB[3]
+	3 MC PC		; C += MC[3]
+	3 MD PC		; C += MD[3]
+	1023 K T	; stop
+	.word 0
+v:	.word 12
@@ -0,0 +1,20 @@
+; Sources NA and NB read the console switches, and source I reads a row of
+; the input tape, OR-ed with the IS switches. Destination Q selects decimal
+; input (any digit entering) or binary input (zero). In decimal mode the
+; lowest data hole punched gives the digit, and holes 11 and 12 pass through.
+; Past the end of the tape, I reads blank tape.
+;
+; setup: NA=(1,2,3,4) NB=-1 IS=(16,0,0,0) tape=5,4095,1152,2048
+; want: A=(1,2,3,4) B=-1 C=524293 D1=528383 D2=525319 D3=526336 D4=524288
+; want: I=0
+	NA A		; A = NA
+	NB B		; B = NB
+	I  C		; C = row 0 | IS
+	1 I D		; D1 = row 1 | IS
+	PL Q		; decimal input
+	2 I D		; D2 = digit 7 and hole 11 | IS
+	3 I D		; D3 = hole 12 only | IS
+	Z  Q		; binary input
+	4 I D		; D4 = blank tape | IS
+	1023 K T	; stop
+	.word 0
@@ -0,0 +1,19 @@
+; Destination S jumps to the address in the upper half of the entering
+; number, and PS adds to S (after S has moved on to the next instruction).
+; Destination Z does nothing, and T stops only if a digit enters.
+;
+; setup: D3=3
+; want: A=0 B=1 C=3 steps=17
+	target K S	; goto target
+	PL A		; skipped
+target:	PL B		; B = 1
+	Z  T		; doesn't stop
+	PL Z		; does nothing
+loop:	PL PC		; C += 1
+	3 PL SD		; D3 -= 1
+	3 D  CS		; if D3 != 0 { skip next }
+	2 K  PS		; goto done (skipping two instructions)
+	loop-.-1 K PS	; goto loop
+	PL A		; skipped
+done:	1023 K T	; stop
+	.word 0
@@ -0,0 +1,12 @@
+; Source M reads a cell of the main store, and destination M writes one.
+;
+; setup: M[a]=(1,2,3,4)
+; want: A=(1,2,3,4) M[b]=(1,2,3,4) M[c]=0 steps=4
+	a M  A		; A = a
+	b A  M		; b = A
+	c Z  M		; c = 0
+	1023 K T	; stop
+	.word 0
+a:	.word 0
+b:	.word 5
+c:	.word 9
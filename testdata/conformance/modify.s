@@ -0,0 +1,14 @@
+; Destination PK adds the entering number to the next instruction as it is
+; executed, changing its address, source or destination. The instruction in
+; the main store isn't changed.
+;
+; want: A=31 M[next]=(0,7,0,4) D1=30
+	2 K  PK		; the next instruction reads data+2
+next:	data M A
+	1 A  D
+	PL PK		; the next instruction adds instead (M A becomes M PA)
+	one M A
+	1023 K T	; stop
+	.word 0
+data:	.word 10, 20, 30
+one:	.word 1
@@ -0,0 +1,38 @@
+; Destination XB multiplies C by the entering number. The signs are
+; combined separately from the 19-digit magnitudes, the upper digits of the
+; product are added into A, and the lower 19 digits replace p2-p20 of B.
+;
+; want: D1=0 D2=30 D3=0.25 D4=0 D5=(16,0,0,1) D6=(31,31,31,28) D7=0.25 D8=0
+; want: A=0.5 B=0
+	Z  A
+	three M C
+	five M XB	; 3 * 5
+	1 A  D
+	2 B  D
+	Z  A
+	half M C
+	half M XB	; 0.5 * 0.5
+	3 A  D
+	4 B  D
+	Z  A
+	m1 M C
+	two M XB	; (-1) * 2: signs and magnitudes are separate
+	5 A  D
+	6 B  D
+	Z  A
+	mhalf M C
+	mhalf M XB	; (-0.5) * (-0.5)
+	7 A  D
+	8 B  D
+	quarter M A
+	half M C
+	half M XB	; A = 0.25 + 0.5 * 0.5
+	1023 K T	; stop
+	.word 0
+three:	.word 3
+five:	.word 5
+two:	.word 2
+m1:	.word -1
+half:	.word 0.5
+mhalf:	.word -0.5
+quarter: .word 0.25
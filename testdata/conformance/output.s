@@ -0,0 +1,13 @@
+; Destinations OT, OP and P send words to the teleprinter, the tape punch
+; and the loudspeaker.
+;
+; want: OT=8,(1,2,3,4) OP=8 P=(1,2,3,4),(16,0,0,0)
+	a M  OT		; print a
+	b M  OT		; print b
+	a M  OP		; punch a
+	b M  P		; sound b
+	PS P		; sound p20
+	1023 K T	; stop
+	.word 0
+a:	.word 8
+b:	.word (1,2,3,4)
@@ -0,0 +1,31 @@
+; Destination L shifts A and B left together as one 40-digit register,
+; rotating p20 of each into p1 of the other, but only if p20 of the entering
+; number is 1. The number of places is 1 plus digits p12-p15 of the entering
+; number: the manual gives 16 0 K L to 16 12 K L for one to seven places,
+; but larger counts work too, and p11 is ignored.
+;
+; want: D1=3 D2=0 D3=0 D4=6 D5=128 D6=32768 D7=32768 D8=65536 B=0
+	one M A
+	sign M B
+	16 0 K L	; one place
+	1 A  D
+	2 B  D
+	sign M A
+	one M B
+	16 2 K L	; two places, rotating p20 of A into B
+	3 A  D
+	4 B  D
+	one M A
+	Z  B
+	16 12 K L	; seven places
+	5 A  D
+	16 14 K L	; eight places
+	6 A  D
+	15 31 K L	; p20 is 0, so no shift
+	7 A  D
+	16 1 K L	; p11 is ignored, so one place
+	8 A  D
+	1023 K T	; stop
+	.word 0
+one:	.word 1
+sign:	.word (16,0,0,0)
@@ -0,0 +1,24 @@
+; Destination CS skips one instruction if any of p1-p11 or p15-p20 enters,
+; and two if digits enter in both groups. Digits in p12-p14 don't skip.
+;
+; want: D1=0 D2=0 D3=1 D4=0 D5=1 D6=0 D7=1 D8=1 D9=1 steps=11
+	both M CS	; skip two
+	1 PL PD		; skipped
+	2 PL PD		; skipped
+	3 PL PD
+	low M CS	; skip one
+	4 PL PD		; skipped
+	5 PL PD
+	high M CS	; skip one
+	6 PL PD		; skipped
+	7 PL PD
+	mid M CS	; don't skip
+	8 PL PD
+	Z  CS		; don't skip
+	9 PL PD
+	1023 K T	; stop
+	.word 0
+both:	.word (16,0,0,1)
+low:	.word (0,1,0,0)
+high:	.word (1,0,0,0)
+mid:	.word (0,8,0,0)
@@ -0,0 +1,17 @@
+; Sources A, SA, HA, TA, LA, CA and ZA read the A register in different
+; ways. HA shifts right keeping the sign; TA shifts left, losing p20.
+;
+; setup: A=-6
+; want: D1=-6 D2=(16,0,0,0) D3=-3 D4=-12 D5=0 D6=1 D7=-6 D8=0 D9=1 A=1
+	1 A  D		; D1 = A
+	2 SA D		; D2 = sign of A, left in p20
+	3 HA D		; D3 = A / 2
+	4 TA D		; D4 = A * 2
+	5 LA D		; D5 = least significant digit of A
+	6 ZA D		; D6 = A != 0
+	7 CA D		; D7 = A, and clear A
+	8 ZA D		; D8 = A != 0
+	PL PA		; A = 1
+	9 LA D		; D9 = least significant digit of A
+	1023 K T	; stop
+	.word 0
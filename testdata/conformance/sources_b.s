@@ -0,0 +1,13 @@
+; Sources B, R and RB read the B register. R gives the sign of B as p1, and
+; RB shifts right bringing in a zero.
+;
+; setup: B=-2
+; want: D1=-2 D2=1 D3=524287 D4=0 D5=0 B=1
+	1 B  D		; D1 = B
+	2 R  D		; D2 = sign of B, as p1
+	3 RB D		; D3 = B >> 1
+	PL B		; B = 1
+	4 R  D		; D4 = sign of B
+	5 RB D		; D5 = B >> 1
+	1023 K T	; stop
+	.word 0
@@ -0,0 +1,13 @@
+; Sources C, SC and RC read the C register. SC leaves the sign in p20, and
+; RC shifts right bringing in a zero.
+;
+; setup: C=-1
+; want: D1=-1 D2=(16,0,0,0) D3=524287 D4=0 D5=0 C=1
+	1 C  D		; D1 = C
+	2 SC D		; D2 = sign of C, left in p20
+	3 RC D		; D3 = C >> 1
+	PL C		; C = 1
+	4 SC D		; D4 = sign of C
+	5 RC D		; D5 = C >> 1
+	1023 K T	; stop
+	.word 0
@@ -0,0 +1,11 @@
+; Sources D, SD and RD read a D register. Only the lowest four digits of the
+; address choose the register, so 16 D reads D0.
+;
+; setup: D0=7 D3=-4
+; want: A=3 B=(16,0,0,0) C=524286
+	3 D  A		; A = D3
+	3 SD B		; B = sign of D3, left in p20
+	3 RD C		; C = D3 >> 1
+	16 D PA		; A += D0
+	1023 K T	; stop
+	.word 0
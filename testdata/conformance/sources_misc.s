@@ -0,0 +1,19 @@
+; Sources Z, HL, HU, S, PE, PL, K and PS give constants and the H and S
+; registers. S holds the address of the instruction being executed in its
+; upper half, and K gives the address field of the instruction in its upper
+; half.
+;
+; setup: H=5 D7=9
+; want: D1=5 D2=5120 D3=(0,2,0,0) D4=(0,1,0,0) D5=1 D6=(16,0,0,0) D7=0
+; want: D8=(0,8,0,0) D15=(31,31,0,0)
+	1 HL D		; D1 = H as a lower half
+	2 HU D		; D2 = H as an upper half
+	3 S  D		; D3 = S (this is address 2)
+	4 PE D		; D4 = p11
+	5 PL D		; D5 = p1
+	6 PS D		; D6 = p20
+	7 Z  D		; D7 = 0
+	8 K  D		; D8 = 8 in the upper half
+	1023 K D	; D15 = 1023 in the upper half
+	1023 K T	; stop
+	.word 0
@@ -0,0 +1,65 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package trace_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/DrJosh9000/CSIRAC"
+	"github.com/DrJosh9000/CSIRAC/trace/tracetest"
+)
+
+func TestGolden(t *testing.T) {
+	tests := []struct {
+		name, prog string
+		signAtP1   bool
+	}{
+		// The sample loops from the programming guide.
+		{"countdown", "countdown", false},
+		{"countup", "countup", false},
+		{"strobe", "strobe", false},
+		{"pksum", "pksum", false},
+
+		// Both readings of the sign-bit sources.
+		{"sign", "sign", false},
+		{"sign_p1", "sign", true},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			src, err := os.ReadFile("testdata/" + test.prog + ".s")
+			if err != nil {
+				t.Fatalf("ReadFile() = %v", err)
+			}
+			prog, err := csirac.Assemble(strings.NewReader(string(src)))
+			if err != nil {
+				t.Fatalf("Assemble() = %v", err)
+			}
+			c := &csirac.CSIRAC{
+				A:        13,
+				B:        47,
+				M:        prog.Words,
+				SignAtP1: test.signAtP1,
+				Budget:   csirac.Budget{Instructions: 1000},
+			}
+			c.K = c.M[0]
+			tracetest.Golden(t, c, "testdata/"+test.name+".trace")
+		})
+	}
+}
@@ -0,0 +1,8 @@
+; Adds B to A 9 times, using a "count down" loop.
+	8 K  C		; C = 8
+loop:	B  PA		; A += B
+	PE SC		; C--
+	SC CS		; if C < 0 { skip next }
+	loop K  S	; goto loop
+	1023 K  T	; stop
+	.word 0
@@ -0,0 +1,9 @@
+; Adds B to A 9 times, using a "count up" loop.
+	-9 K  C		; C = -9
+loop:	B  PA		; A += B
+	PE PC		; C++
+	SC CS		; if C < 0 { skip next }
+	PE PS		; skip next
+	loop-.-1 K PS	; goto loop
+	PS T		; stop
+	.word 0
@@ -0,0 +1,12 @@
+; Sums some numbers in memory, using a loop that varies the next command
+; with PK.
+	A  SA		; A = 0
+	8 K  C		; C = 8
+loop:	C  PK		; next command += C
+	data M PA	; A += data[C]
+	PE SC		; C--
+	SC CS		; if C < 0 { skip next }
+	loop-.-1 K PS	; goto loop
+	PL T		; stop
+	.word 0
+data:	.word 14, 2, 3, 10, 8, 3, 2, 9, 6
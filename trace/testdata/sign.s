@@ -0,0 +1,11 @@
+; Adds the sign of C to A, then doubles A until its sign is set. The sign
+; bit sources read differently with SignAtP1, and so does everything after.
+	A  SA		; A = 0
+	-1 K  C		; C = -1
+	SC PA		; A += sign of C
+loop:	SA CS		; if A < 0 { skip next }
+	PE PS		; skip next
+	1023 K  T	; stop
+	A  PA		; A += A
+	loop K  S	; goto loop
+	.word 0
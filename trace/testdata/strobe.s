@@ -0,0 +1,8 @@
+; Adds B to A 9 times, using a "strobe" loop.
+	PE C		; C = P11
+loop:	B  PA		; A += B
+	C  PC		; C += C
+	SC CS		; if C < 0 { skip next }
+	loop-.-1 K PS	; goto loop
+	PL T		; stop
+	.word 0
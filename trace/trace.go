@@ -0,0 +1,168 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package trace records what a program does, one instruction at a time. Tests
+// can compare the record with a golden copy (see package tracetest). When the
+// machine's behaviour changes (for example, when the meaning of a source or
+// destination is corrected), the golden traces show exactly which programs
+// behave differently, and from which instruction.
+package trace
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/DrJosh9000/CSIRAC"
+)
+
+// Change is a register, store cell, or output changed by an instruction.
+type Change struct {
+	// Name is a register (A, B, C, H, I, D0 to D15, S, or Decimal for the
+	// input mode), a store cell (such as M[12] or MA[3]), or an output
+	// (OT, OP or P).
+	Name  string
+	Value csirac.Word
+}
+
+// Entry is one instruction executed, and what it changed.
+type Entry struct {
+	Step    int64       // instructions executed before this one
+	Addr    int         // the address of the instruction
+	K       csirac.Word // the instruction, as executed
+	Changes []Change
+}
+
+// String formats the entry as one line: the step, the address, the
+// instruction, and the changes. Registers are only listed if their value
+// changed, S only if the instruction jumped or skipped, and store cells and
+// outputs whenever they were written.
+func (e Entry) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%6d %4d  %s", e.Step, e.Addr, e.K.InstructionString())
+	for _, c := range e.Changes {
+		fmt.Fprintf(&b, "  %s=%s", c.Name, strings.ReplaceAll(c.Value.String(), " ", ""))
+	}
+	return b.String()
+}
+
+// Tracer records the instructions executed by a machine.
+type Tracer struct {
+	Entries []Entry
+}
+
+// registers is the part of the machine state compared before and after
+// each instruction.
+type registers struct {
+	A, B, C, H, I csirac.Word
+	D             [16]csirac.Word
+	Decimal       bool
+}
+
+func registersOf(c *csirac.CSIRAC) registers {
+	return registers{A: c.A, B: c.B, C: c.C, H: c.H, I: c.I, D: c.D, Decimal: c.Decimal}
+}
+
+// outputNames names the destinations of the output events.
+var outputNames = map[csirac.EventKind]string{
+	csirac.EventPrint: "OT",
+	csirac.EventPunch: "OP",
+	csirac.EventSound: "P",
+}
+
+// Step records the instruction in K, and executes it with c.Step.
+func (t *Tracer) Step(c *csirac.CSIRAC) error {
+	e := Entry{Step: c.Instructions, Addr: int(c.S.Hi()), K: c.K}
+	before := registersOf(c)
+
+	// Watch the outputs during the step.
+	detach := c.Attach(csirac.DeviceFunc(func(_ *csirac.CSIRAC, ev csirac.Event) error {
+		if name, ok := outputNames[ev.Kind]; ok {
+			e.Changes = append(e.Changes, Change{name, ev.Word})
+		}
+		return nil
+	}))
+	err := c.Step()
+	detach()
+	var be *csirac.ErrBudgetExceeded
+	if errors.As(err, &be) {
+		// Nothing was executed.
+		return err
+	}
+
+	after := registersOf(c)
+	for _, r := range []struct {
+		name          string
+		before, after csirac.Word
+	}{
+		{"A", before.A, after.A}, {"B", before.B, after.B}, {"C", before.C, after.C},
+		{"H", before.H, after.H}, {"I", before.I, after.I},
+	} {
+		if r.before != r.after {
+			e.Changes = append(e.Changes, Change{r.name, r.after})
+		}
+	}
+	for i := range before.D {
+		if before.D[i] != after.D[i] {
+			e.Changes = append(e.Changes, Change{fmt.Sprintf("D%d", i), after.D[i]})
+		}
+	}
+	if before.Decimal != after.Decimal {
+		var w csirac.Word
+		if after.Decimal {
+			w = 1
+		}
+		e.Changes = append(e.Changes, Change{"Decimal", w})
+	}
+	n := e.K.Hi()
+	switch d := e.K.Dest(); {
+	case d == 0:
+		e.Changes = append(e.Changes, Change{fmt.Sprintf("M[%d]", n), c.M[n]})
+	case d >= 27 && d <= 30:
+		drum := [...][]csirac.Word{c.MA, c.MB, c.MC, c.MD}[d-27]
+		e.Changes = append(e.Changes, Change{fmt.Sprintf("M%c[%d]", 'A'+d-27, n), drum[n]})
+	}
+	if int(c.S.Hi()) != (e.Addr+1)%1024 {
+		e.Changes = append(e.Changes, Change{"S", c.S})
+	}
+	t.Entries = append(t.Entries, e)
+	return err
+}
+
+// Run runs the machine until it stops, like c.Run(0, false), recording each
+// instruction. Set a budget on the machine if the program might not stop.
+func (t *Tracer) Run(c *csirac.CSIRAC) error {
+	for {
+		if err := t.Step(c); err != nil {
+			if errors.Is(err, csirac.ErrStop) {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// WriteTo writes the trace, one entry per line.
+func (t *Tracer) WriteTo(w io.Writer) (int64, error) {
+	var b strings.Builder
+	for _, e := range t.Entries {
+		b.WriteString(e.String())
+		b.WriteByte('\n')
+	}
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
@@ -0,0 +1,93 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package trace
+
+import (
+	"testing"
+
+	"github.com/DrJosh9000/CSIRAC"
+)
+
+func TestEntryString(t *testing.T) {
+	tests := []struct {
+		e    Entry
+		want string
+	}{
+		{
+			e:    Entry{Step: 0, Addr: 0, K: csirac.MustParseInstruction(" 0  8 K  C")},
+			want: "     0    0   0  8  K  C",
+		},
+		{
+			e: Entry{
+				Step: 12, Addr: 345, K: csirac.MustParseInstruction(" 0  0 B  PA"),
+				Changes: []Change{{"A", 60}, {"M[3]", 1}},
+			},
+			want: "    12  345   0  0  B PA  A=(0,0,1,28)  M[3]=(0,0,0,1)",
+		},
+	}
+	for _, test := range tests {
+		if got := test.e.String(); got != test.want {
+			t.Errorf("%+v.String() = %q, want %q", test.e, got, test.want)
+		}
+	}
+}
+
+func TestTracer(t *testing.T) {
+	c := &csirac.CSIRAC{
+		M: []csirac.Word{
+			csirac.MustParseInstruction(" 0  5 K  C"),
+			csirac.MustParseInstruction(" 0  6 C  M"),
+			csirac.MustParseInstruction(" 0  0 C  OT"),
+			csirac.MustParseInstruction(" 0  5 K  S"),
+			0,
+			csirac.MustParseInstruction("31 31 K  T"),
+			0,
+			0,
+		},
+	}
+	c.K = c.M[0]
+	var printed []csirac.Word
+	c.Attach(csirac.DeviceFunc(func(_ *csirac.CSIRAC, e csirac.Event) error {
+		if e.Kind == csirac.EventPrint {
+			printed = append(printed, e.Word)
+		}
+		return nil
+	}))
+	tr := new(Tracer)
+	if err := tr.Run(c); err != nil {
+		t.Fatalf("Run() = %v", err)
+	}
+	five := csirac.Word(5 << 10)
+	want := []Entry{
+		{Step: 0, Addr: 0, K: c.M[0], Changes: []Change{{"C", five}}},
+		{Step: 1, Addr: 1, K: c.M[1], Changes: []Change{{"M[6]", five}}},
+		{Step: 2, Addr: 2, K: c.M[2], Changes: []Change{{"OT", five}}},
+		{Step: 3, Addr: 3, K: c.M[3], Changes: []Change{{"S", five}}},
+		{Step: 4, Addr: 5, K: c.M[5]},
+	}
+	if len(tr.Entries) != len(want) {
+		t.Fatalf("traced %d entries, want %d:\n%v", len(tr.Entries), len(want), tr.Entries)
+	}
+	for i, e := range tr.Entries {
+		if got, want := e.String(), want[i].String(); got != want {
+			t.Errorf("entry %d = %q, want %q", i, got, want)
+		}
+	}
+	if len(printed) != 1 || printed[0] != five {
+		t.Errorf("printer received %v, want [%v]", printed, five)
+	}
+}
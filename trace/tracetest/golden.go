@@ -0,0 +1,127 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package tracetest compares traces of programs with golden copies kept
+// with the tests (see package trace).
+package tracetest
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/DrJosh9000/CSIRAC"
+	"github.com/DrJosh9000/CSIRAC/trace"
+)
+
+// update is set by tests run with -update-traces.
+var update = flag.Bool("update-traces", false, "rewrite golden trace files instead of comparing with them")
+
+// goldenContext is the number of matching lines shown before a difference.
+const goldenContext = 3
+
+// Golden runs the machine until it stops, tracing each instruction, and
+// compares the trace with the golden file at path. If the tests are run with
+// the -update-traces flag, the golden file is written instead (creating its
+// directory if needed), for example:
+//
+//	go test ./trace -update-traces
+//
+// Set a budget on the machine if the program might not stop.
+func Golden(tb testing.TB, c *csirac.CSIRAC, path string) {
+	tb.Helper()
+	t := new(trace.Tracer)
+	if err := t.Run(c); err != nil {
+		tb.Fatalf("running %s: %v", path, err)
+	}
+	var b strings.Builder
+	if _, err := t.WriteTo(&b); err != nil {
+		tb.Fatalf("writing trace: %v", err)
+	}
+	got := b.String()
+
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			tb.Fatalf("creating golden trace directory: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+			tb.Fatalf("writing golden trace: %v", err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		tb.Fatalf("reading golden trace (run with -update-traces to create it): %v", err)
+	}
+	if msg := diff(got, string(want)); msg != "" {
+		tb.Errorf("trace differs from %s (run with -update-traces to accept the change):\n%s", path, msg)
+	}
+}
+
+// diff describes the first difference between two traces, or returns ""
+// if they are the same.
+func diff(got, want string) string {
+	if got == want {
+		return ""
+	}
+	gl := strings.Split(strings.TrimSuffix(got, "\n"), "\n")
+	wl := strings.Split(strings.TrimSuffix(want, "\n"), "\n")
+	i := 0
+	for i < len(gl) && i < len(wl) && gl[i] == wl[i] {
+		i++
+	}
+	if i == len(gl) && i == len(wl) {
+		// The lines are the same, so only the final newline differs (the
+		// golden file was probably edited by hand).
+		if strings.HasSuffix(got, "\n") {
+			return "the golden trace is missing the final newline"
+		}
+		return "the golden trace has a final newline, but the trace doesn't"
+	}
+	var b strings.Builder
+	start := i - goldenContext
+	if start < 0 {
+		start = 0
+	}
+	for _, l := range gl[start:i] {
+		b.WriteString("      " + l + "\n")
+	}
+	switch {
+	case i < len(gl) && i < len(wl):
+		b.WriteString(" got: " + gl[i] + "\n")
+		b.WriteString("want: " + wl[i] + "\n")
+	case i < len(gl):
+		b.WriteString(" got: " + gl[i] + "\n")
+		b.WriteString("want: (end of trace)\n")
+	default:
+		b.WriteString(" got: (end of trace)\n")
+		b.WriteString("want: " + wl[i] + "\n")
+	}
+	b.WriteString("(" + lineCount(len(gl)) + " traced, " + lineCount(len(wl)) + " in the golden trace)")
+	return b.String()
+}
+
+// lineCount formats a number of lines.
+func lineCount(n int) string {
+	if n == 1 {
+		return "1 line"
+	}
+	return strconv.Itoa(n) + " lines"
+}
@@ -0,0 +1,36 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package tracetest
+
+import "testing"
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		got, want, diff string
+	}{
+		{"a\nb\n", "a\nb\n", ""},
+		{"a\nb\nc\n", "a\nx\nc\n", "      a\n got: b\nwant: x\n(3 lines traced, 3 lines in the golden trace)"},
+		{"a\n", "a\nb\n", "      a\n got: (end of trace)\nwant: b\n(1 line traced, 2 lines in the golden trace)"},
+		{"a\n", "a", "the golden trace is missing the final newline"},
+		{"a", "a\n", "the golden trace has a final newline, but the trace doesn't"},
+	}
+	for _, test := range tests {
+		if got := diff(test.got, test.want); got != test.diff {
+			t.Errorf("diff(%q, %q) = %q, want %q", test.got, test.want, got, test.diff)
+		}
+	}
+}
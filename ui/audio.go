@@ -0,0 +1,68 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"image"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2/audio"
+)
+
+const sampleRate = 48000
+
+// startAudio starts playing the machine's loudspeaker.
+func startAudio(m *machine) (*audio.Player, error) {
+	p, err := audio.NewContext(sampleRate).NewPlayer(m.speaker)
+	if err != nil {
+		return nil, err
+	}
+	p.SetVolume(0.5)
+	p.Play()
+	return p, nil
+}
+
+// audioWidgets returns the controls for the loudspeaker: volume buttons, and
+// a button to switch the loudspeaker model (which turns the digit pulses
+// into clicks) on and off.
+func audioWidgets(m *machine, p *audio.Player, origin image.Point) []widget {
+	btn := func(caption func() string, n int, press func(), lit func() bool) *button {
+		at := origin.Add(image.Pt(n*64, 0))
+		return &button{
+			caption: caption,
+			r:       image.Rectangle{Min: at, Max: at.Add(image.Pt(56, 22))},
+			press:   press,
+			lit:     lit,
+		}
+	}
+	static := func(s string) func() string { return func() string { return s } }
+	volume := func(d float64) func() {
+		return func() { p.SetVolume(math.Max(0, math.Min(1, p.Volume()+d))) }
+	}
+	return []widget{
+		btn(static("VOL-"), 0, volume(-0.1), nil),
+		btn(static("VOL+"), 1, volume(0.1), nil),
+		btn(static("CLICK"), 2, func() {
+			m.speaker.SetFilter(!m.speaker.Filter())
+		}, m.speaker.Filter),
+		&label{
+			at:   origin.Add(image.Pt(3*64, 3)),
+			text: func() string { return fmt.Sprintf("VOLUME %d%%", int(math.Round(p.Volume()*100))) },
+		},
+	}
+}
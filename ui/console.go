@@ -0,0 +1,223 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"image"
+	"image/color"
+
+	"github.com/DrJosh9000/CSIRAC"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+)
+
+var (
+	panelColour  = color.RGBA{0x45, 0x45, 0x45, 0xff}
+	switchOn     = color.RGBA{0xdd, 0xdd, 0xdd, 0xff}
+	switchOff    = color.RGBA{0x22, 0x22, 0x22, 0xff}
+	lampOn       = color.RGBA{0x55, 0xff, 0x55, 0xff}
+	lampOff      = color.RGBA{0x00, 0x33, 0x00, 0xff}
+	buttonColour = color.RGBA{0x77, 0x77, 0x77, 0xff}
+	buttonLit    = color.RGBA{0x00, 0xaa, 0x00, 0xff}
+)
+
+const (
+	charWidth     = 6  // width of the debug font
+	charHeight    = 16 // height of the debug font
+	bitPitch      = 12 // horizontal distance between switches or lamps
+	groupGap      = 6  // extra gap after every 5 switches or lamps
+	rowLabelWidth = 24 // space for labels to the left of rows
+)
+
+// widget is one part of the user interface.
+type widget interface {
+	// bounds is the area covered by the widget.
+	bounds() image.Rectangle
+
+	// draw draws the widget.
+	draw(dst *ebiten.Image)
+
+	// click handles a mouse click at p (which is within bounds).
+	click(p image.Point)
+}
+
+// bitRow is a row of switches or lamps, one per bit of a word, with the most
+// significant bit on the left. Bits are grouped in fives, as in the number
+// train notation.
+type bitRow struct {
+	label string
+	at    image.Point
+	bits  int
+	get   func() csirac.Word
+	set   func(csirac.Word) // nil for lamps
+}
+
+// bitX returns the x position of the bit n places from the left.
+func (r *bitRow) bitX(n int) int {
+	return r.at.X + rowLabelWidth + n*bitPitch + (n/5)*groupGap
+}
+
+func (r *bitRow) bounds() image.Rectangle {
+	return image.Rect(r.at.X, r.at.Y, r.bitX(r.bits), r.at.Y+bitPitch)
+}
+
+func (r *bitRow) draw(dst *ebiten.Image) {
+	ebitenutil.DebugPrintAt(dst, r.label, r.at.X, r.at.Y-3)
+	w := r.get()
+	for n := 0; n < r.bits; n++ {
+		on := w&(1<<(r.bits-1-n)) != 0
+		x, y := float64(r.bitX(n)), float64(r.at.Y)
+		if r.set == nil {
+			// A lamp
+			c := lampOff
+			if on {
+				c = lampOn
+			}
+			ebitenutil.DrawRect(dst, x+1, y+1, 8, 8, c)
+			continue
+		}
+		// A toggle switch: the lever is up when the switch is on.
+		ebitenutil.DrawRect(dst, x, y, 10, 10, switchOff)
+		if on {
+			ebitenutil.DrawRect(dst, x+3, y, 4, 5, switchOn)
+		} else {
+			ebitenutil.DrawRect(dst, x+3, y+5, 4, 5, switchOn)
+		}
+	}
+}
+
+func (r *bitRow) click(p image.Point) {
+	if r.set == nil {
+		return
+	}
+	for n := 0; n < r.bits; n++ {
+		if x := r.bitX(n); p.X >= x && p.X < x+bitPitch {
+			r.set(r.get() ^ 1<<(r.bits-1-n))
+			return
+		}
+	}
+}
+
+// button is a push button, which can light up.
+type button struct {
+	caption func() string
+	r       image.Rectangle
+	press   func()
+	lit     func() bool // may be nil
+}
+
+func (b *button) bounds() image.Rectangle { return b.r }
+
+func (b *button) draw(dst *ebiten.Image) {
+	c := buttonColour
+	if b.lit != nil && b.lit() {
+		c = buttonLit
+	}
+	ebitenutil.DrawRect(dst, float64(b.r.Min.X), float64(b.r.Min.Y), float64(b.r.Dx()), float64(b.r.Dy()), c)
+	caption := b.caption()
+	tx := b.r.Min.X + (b.r.Dx()-len(caption)*charWidth)/2
+	ty := b.r.Min.Y + (b.r.Dy()-charHeight)/2
+	ebitenutil.DebugPrintAt(dst, caption, tx, ty)
+}
+
+func (b *button) click(image.Point) { b.press() }
+
+// label is some text, which may change.
+type label struct {
+	at   image.Point
+	text func() string
+}
+
+func (l *label) bounds() image.Rectangle { return image.Rectangle{} }
+
+func (l *label) draw(dst *ebiten.Image) { ebitenutil.DebugPrintAt(dst, l.text(), l.at.X, l.at.Y) }
+
+func (l *label) click(image.Point) {}
+
+// consoleWidgets returns the widgets making up the control console, placed
+// with the top-left corner at origin:
+//
+//   - switches for the NA, NB, IS and T registers,
+//   - lamps showing K and S, and
+//   - buttons to run, stop, single-step and reset the machine, and to enable
+//     the trigger stop (which stops the machine when S reaches the address
+//     set on the T switches), and
+//   - a line for depositing words typed at the keyboard into registers and
+//     store cells (see machine.deposit).
+func consoleWidgets(m *machine, origin image.Point) []widget {
+	row := func(label string, n, bits int, w *csirac.Word) *bitRow {
+		return &bitRow{
+			label: label,
+			at:    origin.Add(image.Pt(0, n*20)),
+			bits:  bits,
+			get:   func() csirac.Word { return *w },
+			set:   func(x csirac.Word) { *w = x },
+		}
+	}
+	btn := func(label string, n int, press func(), lit func() bool) *button {
+		at := origin.Add(image.Pt(320+(n%3)*64, (n/3)*28))
+		return &button{
+			caption: func() string { return label },
+			r:       image.Rectangle{Min: at, Max: at.Add(image.Pt(56, 22))},
+			press:   press,
+			lit:     lit,
+		}
+	}
+	return []widget{
+		row("NA", 0, 20, &m.NA),
+		row("NB", 1, 20, &m.NB),
+		row("IS", 2, 20, &m.IS),
+		row("T", 3, 10, &m.T),
+		&bitRow{
+			label: "K",
+			at:    origin.Add(image.Pt(0, 96)),
+			bits:  20,
+			get:   func() csirac.Word { return m.K },
+		},
+		&bitRow{
+			label: "S",
+			at:    origin.Add(image.Pt(0, 116)),
+			bits:  10,
+			get:   func() csirac.Word { return m.S.Hi() },
+		},
+		btn("RUN", 0, m.run, func() bool { return m.running }),
+		btn("STOP", 1, m.stop, nil),
+		btn("STEP", 2, func() {
+			if !m.running {
+				m.step()
+			}
+		}, nil),
+		btn("RESET", 3, m.reset, nil),
+		btn("TRIG", 4, func() { m.trigger = !m.trigger }, func() bool { return m.trigger }),
+		&label{
+			at: origin.Add(image.Pt(320, 60)),
+			text: func() string {
+				if m.err != nil {
+					return m.err.Error()
+				}
+				return ""
+			},
+		},
+		&entry{
+			prompt: "DEPOSIT ",
+			r:      image.Rectangle{Min: origin.Add(image.Pt(320, 84)), Max: origin.Add(image.Pt(536, 106))},
+			enter: func(line string) {
+				m.err = m.deposit(line)
+			},
+		},
+	}
+}
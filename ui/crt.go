@@ -0,0 +1,188 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"image"
+
+	"github.com/DrJosh9000/CSIRAC"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+)
+
+// Glyphs in crtsym.png, each 8x8.
+var (
+	crtZero   = crtsym.SubImage(image.Rect(0, 0, 8, 8)).(*ebiten.Image)
+	crtOne    = crtsym.SubImage(image.Rect(8, 0, 16, 8)).(*ebiten.Image)
+	crtMarker = crtsym.SubImage(image.Rect(16, 0, 24, 8)).(*ebiten.Image)
+)
+
+const (
+	tubeRowPitch   = 14 // vertical distance between words on a tube
+	tubeLabelWidth = 30 // space for labels to the left of words
+	tubeWidth      = tubeLabelWidth + 20*8
+)
+
+// tubeRow is one word shown on a tube.
+type tubeRow struct {
+	label  string
+	w      csirac.Word
+	marked bool // draws a marker to the left of the label
+}
+
+// tube is a CRT monitor tube. As on the original tubes, each word is shown
+// as a trace along which each 1 digit makes a pulse. p1 is on the left, since
+// the digits of a word came out of the delay lines least significant first.
+// In the decoded view, words are shown as number trains and instructions.
+type tube struct {
+	title   string
+	at      image.Point
+	rows    func() []tubeRow
+	decoded *bool
+}
+
+func (t *tube) bounds() image.Rectangle {
+	return image.Rect(t.at.X, t.at.Y, t.at.X+tubeWidth, t.at.Y+tubeRowPitch*(len(t.rows())+1))
+}
+
+func (t *tube) draw(dst *ebiten.Image) {
+	ebitenutil.DebugPrintAt(dst, t.title, t.at.X+tubeLabelWidth, t.at.Y-2)
+	for i, r := range t.rows() {
+		y := t.at.Y + (i+1)*tubeRowPitch
+		if r.marked {
+			var op ebiten.DrawImageOptions
+			op.GeoM.Translate(float64(t.at.X-10), float64(y))
+			dst.DrawImage(crtMarker, &op)
+		}
+		ebitenutil.DebugPrintAt(dst, r.label, t.at.X, y-4)
+		if *t.decoded {
+			ebitenutil.DebugPrintAt(dst, fmt.Sprintf("%v %s", r.w, r.w.InstructionString()), t.at.X+tubeLabelWidth, y-4)
+			continue
+		}
+		for n := 0; n < 20; n++ {
+			g := crtZero
+			if r.w&(1<<n) != 0 {
+				g = crtOne
+			}
+			var op ebiten.DrawImageOptions
+			op.GeoM.Translate(float64(t.at.X+tubeLabelWidth+8*n), float64(y))
+			dst.DrawImage(g, &op)
+		}
+	}
+}
+
+func (t *tube) click(image.Point) {}
+
+// storeView is the part of a store shown on the store tube.
+type storeView struct {
+	store int // 0 for M, 1-4 for MA-MD
+	base  int // the first address shown
+}
+
+// storeNames are the names of the stores, indexed by storeView.store.
+var storeNames = []string{"M", "MA", "MB", "MC", "MD"}
+
+const storeRows = 20 // words shown at once on the store tube
+
+// words returns the store being viewed.
+func (v *storeView) words(m *machine) []csirac.Word {
+	return [][]csirac.Word{m.M, m.MA, m.MB, m.MC, m.MD}[v.store]
+}
+
+// page moves the view by n pages.
+func (v *storeView) page(n int) {
+	v.base = ((v.base+n*storeRows)%1024 + 1024) % 1024
+}
+
+// monitorWidgets returns the widgets making up the monitor: one tube for the
+// registers and one for the stores, and buttons to choose which part of
+// which store to show, and to switch between the dot and decoded views.
+func monitorWidgets(m *machine, origin image.Point) []widget {
+	decoded := new(bool)
+	view := new(storeView)
+	regs := &tube{
+		title:   "REGISTERS",
+		at:      origin,
+		decoded: decoded,
+		rows: func() []tubeRow {
+			rows := []tubeRow{
+				{label: "A", w: m.A},
+				{label: "B", w: m.B},
+				{label: "C", w: m.C},
+				{label: "H", w: m.H},
+				{label: "S", w: m.S},
+				{label: "K", w: m.K},
+			}
+			for i, d := range m.D {
+				rows = append(rows, tubeRow{label: fmt.Sprintf("D%d", i), w: d})
+			}
+			return rows
+		},
+	}
+	store := &tube{
+		title:   "STORE",
+		at:      origin.Add(image.Pt(tubeWidth+30, 0)),
+		decoded: decoded,
+		rows: func() []tubeRow {
+			words := view.words(m)
+			rows := make([]tubeRow, 0, storeRows)
+			for i := 0; i < storeRows; i++ {
+				a := (view.base + i) % 1024
+				var w csirac.Word
+				if a < len(words) {
+					w = words[a]
+				}
+				rows = append(rows, tubeRow{
+					label:  fmt.Sprint(a),
+					w:      w,
+					marked: view.store == 0 && a == int(m.S.Hi()),
+				})
+			}
+			return rows
+		},
+	}
+	btn := func(caption func() string, n int, press func(), lit func() bool) *button {
+		at := store.at.Add(image.Pt(tubeLabelWidth+n*40, tubeRowPitch*(storeRows+1)+8))
+		return &button{
+			caption: caption,
+			r:       image.Rectangle{Min: at, Max: at.Add(image.Pt(36, 20))},
+			press:   press,
+			lit:     lit,
+		}
+	}
+	static := func(s string) func() string { return func() string { return s } }
+	return []widget{
+		regs,
+		store,
+		btn(func() string { return storeNames[view.store] }, 0, func() {
+			view.store = (view.store + 1) % len(storeNames)
+		}, nil),
+		btn(static("<"), 1, func() { view.page(-1) }, nil),
+		btn(static(">"), 2, func() { view.page(1) }, nil),
+		btn(static("AT S"), 3, func() {
+			view.store = 0
+			view.base = int(m.S.Hi()) / storeRows * storeRows
+		}, nil),
+		btn(func() string {
+			if *decoded {
+				return "TEXT"
+			}
+			return "DOTS"
+		}, 4, func() { *decoded = !*decoded }, nil),
+	}
+}
@@ -0,0 +1,124 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"image"
+	"strconv"
+	"strings"
+
+	"github.com/DrJosh9000/CSIRAC"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+)
+
+// deposit sets a register or store cell from a line of the form
+// "name = word". The name is a register (A, B, C, H, I, or D0 to D15), a
+// console switch register (NA, NB, IS or T), or a store cell (M[addr], or
+// MA[addr] to MD[addr] for the drums). The word is a word literal (see
+// csirac.ParseWord), for example "M[12] = ( 2,18, 4, 0)" or "A = -0.5".
+func (m *machine) deposit(line string) error {
+	i := strings.Index(line, "=")
+	if i < 0 {
+		return fmt.Errorf("deposit %q: want name = word", line)
+	}
+	dst, err := m.register(strings.ToUpper(strings.TrimSpace(line[:i])))
+	if err != nil {
+		return err
+	}
+	w, err := csirac.ParseWord(line[i+1:])
+	if err != nil {
+		return err
+	}
+	if dst == &m.T {
+		w &= 0x3ff // T has only 10 switches
+	}
+	*dst = w
+	return nil
+}
+
+// register returns the register or store cell with the given name.
+func (m *machine) register(name string) (*csirac.Word, error) {
+	regs := map[string]*csirac.Word{
+		"A": &m.A, "B": &m.B, "C": &m.C, "H": &m.H, "I": &m.I,
+		"NA": &m.NA, "NB": &m.NB, "IS": &m.IS, "T": &m.T,
+	}
+	if r := regs[name]; r != nil {
+		return r, nil
+	}
+	if strings.HasPrefix(name, "D") {
+		if n, err := strconv.Atoi(name[1:]); err == nil && n >= 0 && n < len(m.D) {
+			return &m.D[n], nil
+		}
+	}
+	if i := strings.Index(name, "["); i >= 0 && strings.HasSuffix(name, "]") {
+		stores := map[string][]csirac.Word{"M": m.M, "MA": m.MA, "MB": m.MB, "MC": m.MC, "MD": m.MD}
+		st, ok := stores[strings.TrimSpace(name[:i])]
+		if !ok {
+			return nil, fmt.Errorf("unknown store %q", name[:i])
+		}
+		addr := name[i+1 : len(name)-1]
+		n, err := strconv.Atoi(strings.TrimSpace(addr))
+		if err != nil || n < 0 || n >= len(st) {
+			return nil, fmt.Errorf("address %q out of valid range [0,%d]", addr, len(st)-1)
+		}
+		return &st[n], nil
+	}
+	return nil, fmt.Errorf("unknown register %q", name)
+}
+
+// typist is a widget that takes input from the keyboard.
+type typist interface {
+	// typed handles the characters typed since the last tick, and whether
+	// Return or Backspace was pressed.
+	typed(chars []rune, enter, backspace bool)
+}
+
+// entry is a line of text typed at the keyboard, which is entered with
+// Return.
+type entry struct {
+	prompt string
+	r      image.Rectangle
+	enter  func(string)
+	text   []rune
+}
+
+func (e *entry) bounds() image.Rectangle { return e.r }
+
+func (e *entry) draw(dst *ebiten.Image) {
+	ebitenutil.DrawRect(dst, float64(e.r.Min.X), float64(e.r.Min.Y), float64(e.r.Dx()), float64(e.r.Dy()), switchOff)
+	line := []rune(e.prompt + string(e.text) + "_")
+	// Show the end of the line if it is too long to fit.
+	if max := (e.r.Dx() - 4) / charWidth; len(line) > max {
+		line = line[len(line)-max:]
+	}
+	ebitenutil.DebugPrintAt(dst, string(line), e.r.Min.X+2, e.r.Min.Y+(e.r.Dy()-charHeight)/2)
+}
+
+func (e *entry) click(image.Point) {}
+
+func (e *entry) typed(chars []rune, enter, backspace bool) {
+	e.text = append(e.text, chars...)
+	if backspace && len(e.text) > 0 {
+		e.text = e.text[:len(e.text)-1]
+	}
+	if enter && len(e.text) > 0 {
+		e.enter(string(e.text))
+		e.text = e.text[:0]
+	}
+}
@@ -0,0 +1,213 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"image"
+	"image/color"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+)
+
+var (
+	paperColour = color.RGBA{0xee, 0xe8, 0xd0, 0xff}
+	holeColour  = color.RGBA{0x22, 0x22, 0x22, 0xff}
+	headColour  = color.RGBA{0xcc, 0x33, 0x33, 0xff}
+)
+
+// scroller is a widget that can be scrolled with the mouse wheel.
+type scroller interface {
+	scroll(dy float64)
+}
+
+// teleprinterWindow shows the text printed on the teleprinter. New lines
+// appear at the bottom; the mouse wheel scrolls back through earlier ones.
+type teleprinterWindow struct {
+	r    image.Rectangle
+	text func() string
+
+	back  int      // lines scrolled back from the end
+	seen  int      // length of the text when lines was computed
+	lines []string // the text, wrapped to fit the window
+}
+
+func (t *teleprinterWindow) bounds() image.Rectangle { return t.r }
+
+func (t *teleprinterWindow) draw(dst *ebiten.Image) {
+	// The debug font only comes in white, so the paper is shown dark.
+	ebitenutil.DrawRect(dst, float64(t.r.Min.X), float64(t.r.Min.Y), float64(t.r.Dx()), float64(t.r.Dy()), holeColour)
+	if text := t.text(); len(text) != t.seen {
+		t.seen = len(text)
+		t.lines = wrap(text, (t.r.Dx()-8)/charWidth)
+	}
+	rows := (t.r.Dy() - 8) / charHeight
+	if max := len(t.lines) - rows; t.back > max {
+		t.back = max
+	}
+	if t.back < 0 {
+		t.back = 0
+	}
+	end := len(t.lines) - t.back
+	start := end - rows
+	if start < 0 {
+		start = 0
+	}
+	for i, l := range t.lines[start:end] {
+		ebitenutil.DebugPrintAt(dst, l, t.r.Min.X+4, t.r.Min.Y+4+i*charHeight)
+	}
+}
+
+func (t *teleprinterWindow) click(image.Point) {}
+
+func (t *teleprinterWindow) scroll(dy float64) {
+	switch {
+	case dy > 0:
+		t.back++
+	case dy < 0:
+		t.back--
+	}
+}
+
+// wrap splits text into lines of at most width characters.
+func wrap(text string, width int) []string {
+	var lines []string
+	for _, l := range strings.Split(text, "\n") {
+		for len(l) > width {
+			lines = append(lines, l[:width])
+			l = l[width:]
+		}
+		lines = append(lines, l)
+	}
+	return lines
+}
+
+// tapeStrip shows a length of paper tape running horizontally, one row of
+// holes per column, with hole 1 at the bottom. The small sprocket hole lies
+// between the data holes, as on the real tape.
+type tapeStrip struct {
+	title    string
+	r        image.Rectangle
+	holes    int
+	sprocket int // the sprocket hole is above this many holes
+
+	// rows returns the rows of the tape, and the index of the row under the
+	// head (which may be out of range, if the head is off the end of the
+	// tape). Earlier rows are to the left of the head.
+	rows func() ([]uint16, int)
+
+	// head is the column of the head, counted from the left of the strip.
+	head int
+}
+
+const tapePitch = 6 // distance between rows and holes on a tape
+
+func (t *tapeStrip) bounds() image.Rectangle { return t.r }
+
+func (t *tapeStrip) draw(dst *ebiten.Image) {
+	ebitenutil.DebugPrintAt(dst, t.title, t.r.Min.X, t.r.Min.Y-charHeight)
+	rows, pos := t.rows()
+	// Row i is drawn in column t.head + i - pos.
+	first, last := t.head-pos, t.head+len(rows)-pos
+	if first < 0 {
+		first = 0
+	}
+	if cols := t.r.Dx() / tapePitch; last > cols {
+		last = cols
+	}
+	if first < last {
+		x := float64(t.r.Min.X + first*tapePitch)
+		ebitenutil.DrawRect(dst, x, float64(t.r.Min.Y), float64((last-first)*tapePitch), float64(t.r.Dy()), paperColour)
+	}
+	hx := float64(t.r.Min.X + t.head*tapePitch)
+	ebitenutil.DrawRect(dst, hx-1, float64(t.r.Min.Y-2), tapePitch+2, 2, headColour)
+	ebitenutil.DrawRect(dst, hx-1, float64(t.r.Max.Y), tapePitch+2, 2, headColour)
+	for col := first; col < last; col++ {
+		row := rows[pos+col-t.head]
+		x := float64(t.r.Min.X + col*tapePitch + 1)
+		y := float64(t.r.Max.Y - (t.sprocket+1)*tapePitch)
+		ebitenutil.DrawRect(dst, x+1, y+2, tapePitch-4, tapePitch-4, holeColour)
+		for h := 0; h < t.holes; h++ {
+			if row&(1<<h) == 0 {
+				continue
+			}
+			n := h
+			if h >= t.sprocket {
+				n++
+			}
+			y := float64(t.r.Max.Y - (n+1)*tapePitch)
+			ebitenutil.DrawRect(dst, x, y+1, tapePitch-2, tapePitch-2, holeColour)
+		}
+	}
+}
+
+func (t *tapeStrip) click(image.Point) {}
+
+// deviceWidgets returns the widgets for the teleprinter, the tape punch and
+// the tape reader, with the top-left corner at origin.
+func deviceWidgets(m *machine, origin image.Point) []widget {
+	const width = 464
+	punch := &tapeStrip{
+		title:    "OUTPUT TAPE",
+		r:        image.Rectangle{Min: origin.Add(image.Pt(0, 264)), Max: origin.Add(image.Pt(width, 264+6*tapePitch))},
+		holes:    5,
+		sprocket: 2,
+		head:     width/tapePitch - 4,
+		rows: func() ([]uint16, int) {
+			rows := make([]uint16, len(m.punch.Rows))
+			for i, r := range m.punch.Rows {
+				rows[i] = uint16(r)
+			}
+			// The last row punched is under the head.
+			return rows, len(rows) - 1
+		},
+	}
+	reader := &tapeStrip{
+		title:    "INPUT TAPE",
+		r:        image.Rectangle{Min: origin.Add(image.Pt(0, 336)), Max: origin.Add(image.Pt(width, 336+13*tapePitch))},
+		holes:    12,
+		sprocket: 10,
+		head:     width / tapePitch / 2,
+		rows: func() ([]uint16, int) {
+			if m.Input == nil {
+				return nil, 0
+			}
+			// The last row read is under the head.
+			return m.Input.Rows, m.Input.Pos - 1
+		},
+	}
+	rewind := origin.Add(image.Pt(width-60, 312))
+	return []widget{
+		&teleprinterWindow{
+			r:    image.Rectangle{Min: origin.Add(image.Pt(0, 16)), Max: origin.Add(image.Pt(width, 240))},
+			text: m.printer.String,
+		},
+		&label{at: origin, text: func() string { return "TELEPRINTER" }},
+		punch,
+		reader,
+		&button{
+			caption: func() string { return "REWIND" },
+			r:       image.Rectangle{Min: rewind, Max: rewind.Add(image.Pt(60, 16))},
+			press: func() {
+				if m.Input != nil {
+					m.Input.Pos = 0
+				}
+			},
+		},
+	}
+}
@@ -0,0 +1,94 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/DrJosh9000/CSIRAC"
+	"github.com/DrJosh9000/CSIRAC/tape"
+)
+
+// fileTypes lists the extensions of files that can be loaded, in the form of
+// the accept attribute of a file input.
+const fileTypes = ".s,.asm,.img,.t12,.h12,.snap"
+
+// loadFile loads a file into the machine. What happens depends on the
+// extension of its name:
+//
+//	.img        memory image, loaded into the main store (which resets the
+//	            machine), or onto a drum if the name ends in .ma.img,
+//	            .mb.img, .mc.img or .md.img (as written by cslink)
+//	.t12, .h12  12-hole tape image (raw or holes), put in the tape reader
+//	.snap       snapshot of the whole machine
+//
+// Anything else is assembled and loaded into the main store.
+func (m *machine) loadFile(name string, data []byte) error {
+	switch ext := strings.ToLower(filepath.Ext(name)); ext {
+	case ".img":
+		words, err := csirac.ReadImage(bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+		if len(words) > 1024 {
+			return fmt.Errorf("image has %d words, more than 1024", len(words))
+		}
+		drums := map[string][]csirac.Word{".ma": m.MA, ".mb": m.MB, ".mc": m.MC, ".md": m.MD}
+		drum, ok := drums[strings.ToLower(filepath.Ext(strings.TrimSuffix(name, ext)))]
+		if !ok {
+			m.load(words)
+			return nil
+		}
+		for i := range drum {
+			drum[i] = 0
+		}
+		copy(drum, words)
+		return nil
+
+	case ".t12", ".h12":
+		format := tape.Raw
+		if ext == ".h12" {
+			format = tape.Holes
+		}
+		t, err := tape.ReadInput(bytes.NewReader(data), format)
+		if err != nil {
+			return err
+		}
+		m.loadTape(t)
+		return nil
+
+	case ".snap":
+		s, err := csirac.ReadSnapshot(bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+		m.restore(s)
+		return nil
+	}
+	program, err := csirac.ParseProgram(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	if len(program) > 1024 {
+		return fmt.Errorf("program has %d words, more than 1024", len(program))
+	}
+	m.load(program)
+	return nil
+}
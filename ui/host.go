@@ -0,0 +1,31 @@
+//go:build !js
+// +build !js
+
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import "image"
+
+// Outside the browser, files are only loaded from the command line, so there
+// is nothing more for the host to do.
+
+func hostInit(*machine) error { return nil }
+
+func hostWidgets(*machine, image.Point) []widget { return nil }
+
+func hostUpdate(*machine) {}
@@ -0,0 +1,118 @@
+//go:build js && wasm
+// +build js,wasm
+
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"image"
+
+	"github.com/DrJosh9000/CSIRAC/ui/web"
+)
+
+// snapshotKey is the local storage key for the snapshot saved with SAVE.
+const snapshotKey = "csirac.snapshot"
+
+// opened receives files chosen with the file picker, or the errors from
+// reading them. They are loaded by hostUpdate, since the picker calls back
+// outside of Update.
+var opened = make(chan openResult, 1)
+
+type openResult struct {
+	f   web.File
+	err error
+}
+
+// hostInit loads the files embedded in the page URL.
+func hostInit(m *machine) error {
+	files, err := web.ParseURL(web.PageURL())
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		if err := m.loadFile(f.Name, f.Data); err != nil {
+			return fmt.Errorf("%s: %w", f.Name, err)
+		}
+	}
+	return nil
+}
+
+// hostUpdate loads any file chosen with the file picker.
+func hostUpdate(m *machine) {
+	select {
+	case r := <-opened:
+		if r.err != nil {
+			m.err = r.err
+			return
+		}
+		if err := m.loadFile(r.f.Name, r.f.Data); err != nil {
+			m.err = fmt.Errorf("%s: %w", r.f.Name, err)
+		}
+	default:
+	}
+}
+
+// hostWidgets returns buttons for loading a file from the browser, and for
+// saving and restoring a snapshot in local storage.
+func hostWidgets(m *machine, origin image.Point) []widget {
+	btn := func(label string, n int, press func()) *button {
+		at := origin.Add(image.Pt(n*64, 0))
+		return &button{
+			caption: func() string { return label },
+			r:       image.Rectangle{Min: at, Max: at.Add(image.Pt(56, 22))},
+			press:   press,
+		}
+	}
+	storage := func() (web.Storage, bool) {
+		st, ok := web.LocalStorage()
+		if !ok {
+			m.err = fmt.Errorf("local storage is not available")
+		}
+		return st, ok
+	}
+	return []widget{
+		btn("LOAD", 0, func() {
+			web.OpenFile(fileTypes, func(f web.File, err error) {
+				// Don't block the JavaScript callback.
+				go func() { opened <- openResult{f, err} }()
+			})
+		}),
+		btn("SAVE", 1, func() {
+			st, ok := storage()
+			if !ok {
+				return
+			}
+			if err := web.SaveSnapshot(st, snapshotKey, m.Snapshot()); err != nil {
+				m.err = err
+			}
+		}),
+		btn("RESTORE", 2, func() {
+			st, ok := storage()
+			if !ok {
+				return
+			}
+			s, err := web.LoadSnapshot(st, snapshotKey)
+			if err != nil {
+				m.err = err
+				return
+			}
+			m.restore(s)
+		}),
+	}
+}
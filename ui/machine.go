@@ -0,0 +1,161 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"errors"
+	"time"
+
+	"github.com/DrJosh9000/CSIRAC"
+	"github.com/DrJosh9000/CSIRAC/tape"
+	"github.com/DrJosh9000/CSIRAC/ui/sound"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// machine is a CSIRAC being operated from the console.
+type machine struct {
+	*csirac.CSIRAC
+
+	printer *csirac.Teleprinter // receives words sent to the teleprinter
+	punch   *csirac.Punch       // receives words sent to the tape punch
+	speaker *sound.Speaker      // receives words sent to the loudspeaker
+
+	running bool    // set by RUN, cleared by STOP or when the machine stops
+	trigger bool    // trigger stop: stop when S reaches the address in T
+	speed   float64 // instructions per second while running
+	pending float64 // instructions owed from previous ticks
+	err     error   // the error that stopped the machine, if any
+}
+
+// newMachine returns a machine with a full-sized main store and drums.
+func newMachine(speed float64) *machine {
+	tp, punch := new(csirac.Teleprinter), new(csirac.Punch)
+	c := &csirac.CSIRAC{
+		M:  make([]csirac.Word, 1024),
+		MA: make([]csirac.Word, 1024),
+		MB: make([]csirac.Word, 1024),
+		MC: make([]csirac.Word, 1024),
+		MD: make([]csirac.Word, 1024),
+	}
+	m := &machine{
+		CSIRAC:  c,
+		printer: tp,
+		punch:   punch,
+		speaker: sound.NewSpeaker(sampleRate),
+		speed:   speed,
+	}
+	c.Attach(tp)
+	c.Attach(punch)
+	c.Attach(csirac.DeviceFunc(func(_ *csirac.CSIRAC, e csirac.Event) error {
+		if e.Kind == csirac.EventSound {
+			m.speaker.Pulse(m.playTime(e.Time), e.Word)
+		}
+		return nil
+	}))
+	m.reset()
+	return m
+}
+
+// playTime converts a time on the machine's simulated clock into the time it
+// is heard, when the machine runs at m.speed instead of at its own speed (so
+// that, for example, notes play an octave lower at half speed).
+func (m *machine) playTime(t time.Duration) time.Duration {
+	if m.speed <= 0 {
+		return t
+	}
+	return time.Duration(float64(t) * float64(time.Second) / (m.speed * float64(csirac.InstructionTime)))
+}
+
+// update runs as many instructions as are due since the last tick.
+func (m *machine) update() {
+	if !m.running {
+		m.pending = 0
+		return
+	}
+	m.pending += m.speed / float64(ebiten.MaxTPS())
+	for ; m.pending >= 1; m.pending-- {
+		if !m.step() {
+			return
+		}
+	}
+}
+
+// run starts the machine running.
+func (m *machine) run() {
+	m.err = nil
+	m.running = true
+}
+
+// stop stops the machine.
+func (m *machine) stop() { m.running = false }
+
+// step executes one instruction, and reports whether the machine can keep
+// running.
+func (m *machine) step() bool {
+	m.err = nil
+	if err := m.Step(); err != nil {
+		m.running = false
+		if !errors.Is(err, csirac.ErrStop) {
+			m.err = err
+		}
+		return false
+	}
+	if m.trigger && m.S.Hi() == m.T&0x3ff {
+		m.running = false
+		return false
+	}
+	return true
+}
+
+// reset stops the machine, clears the registers, and prepares to start at
+// address 0. The stores and the console switches are unchanged.
+func (m *machine) reset() {
+	c := m.CSIRAC
+	c.A, c.B, c.C, c.H, c.I = 0, 0, 0, 0, 0
+	c.D = [16]csirac.Word{}
+	c.Decimal = false
+	c.S = 0
+	c.K = c.M[0]
+	m.running = false
+	m.err = nil
+}
+
+// load replaces the main store with a program, and resets the machine.
+func (m *machine) load(program []csirac.Word) {
+	for i := range m.M {
+		m.M[i] = 0
+	}
+	copy(m.M, program)
+	m.reset()
+}
+
+// loadTape puts a tape in the tape reader.
+func (m *machine) loadTape(t tape.Input) { m.Input = t.Load() }
+
+// restore restores a snapshot, and stops the machine.
+func (m *machine) restore(s *csirac.Snapshot) {
+	s.Restore(m.CSIRAC)
+	// Snapshots may have smaller stores than the 1024 words the rest of the
+	// UI expects.
+	for _, st := range []*[]csirac.Word{&m.M, &m.MA, &m.MB, &m.MC, &m.MD} {
+		if len(*st) < 1024 {
+			*st = append(*st, make([]csirac.Word, 1024-len(*st))...)
+		}
+	}
+	m.running = false
+	m.err = nil
+}
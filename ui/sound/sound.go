@@ -0,0 +1,152 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package sound turns the pulses CSIRAC sends to its loudspeaker into audio.
+//
+// Each word sent to the P destination reaches the loudspeaker as a stream of
+// digit pulses, least significant digit first, one pulse for each 1 digit.
+// On its own a word makes a click; programs played notes by sending words at
+// regular intervals, the pitch depending on the time taken around a loop.
+//
+// The pulses are heard through a model of the amplifier and loudspeaker: a
+// low-pass filter (the cone can't follow individual digit pulses, so a word
+// becomes one click) followed by a high-pass filter (the coupling capacitor
+// blocks any steady level, so each click decays back to silence). The filter
+// can be turned off to hear the raw pulses.
+package sound
+
+import (
+	"encoding/binary"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/DrJosh9000/CSIRAC"
+)
+
+const (
+	// DigitTime is the time between digit pulses within a word.
+	DigitTime = time.Second / 330000
+
+	// Latency is how far behind the machine the sound is played. Pulses
+	// arrive in bursts (the machine runs many instructions per frame), so
+	// they are scheduled this far ahead of the audio already produced.
+	Latency = 50 * time.Millisecond
+
+	// maxAhead is how far ahead of the audio produced a pulse can be
+	// scheduled before the speaker resynchronises with the machine.
+	maxAhead = 500 * time.Millisecond
+
+	// Corner frequencies of the loudspeaker model, in Hz.
+	lowPassCorner  = 4000
+	highPassCorner = 150
+)
+
+// Speaker is a loudspeaker. It implements io.Reader, producing 16-bit
+// little-endian stereo samples, suitable for an audio player.
+type Speaker struct {
+	mu     sync.Mutex
+	rate   float64   // samples per second
+	filter bool      // whether to use the loudspeaker model
+	pulses []float64 // times of pulses not yet played, in samples, ascending
+	pos    float64   // time of the next sample to produce, in samples
+
+	// The speaker plays a pulse sent at simulated time t (in samples) at
+	// t + offset. synced reports whether offset has been set.
+	offset float64
+	synced bool
+
+	lowPass, highPass float64 // filter coefficients
+	lp, hp, lpPrev    float64 // filter state
+}
+
+// NewSpeaker returns a speaker producing audio at the given sample rate, with
+// the loudspeaker model turned on.
+func NewSpeaker(sampleRate int) *Speaker {
+	rate := float64(sampleRate)
+	return &Speaker{
+		rate:     rate,
+		filter:   true,
+		lowPass:  1 - math.Exp(-2*math.Pi*lowPassCorner/rate),
+		highPass: math.Exp(-2 * math.Pi * highPassCorner / rate),
+	}
+}
+
+// Pulse sends the digits of a word to the speaker, at time at on the
+// machine's simulated clock.
+func (s *Speaker) Pulse(at time.Duration, w csirac.Word) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := at.Seconds() * s.rate
+	// Resynchronise if the machine has fallen behind the audio (for
+	// example, after being stopped) or run too far ahead.
+	if when := t + s.offset; !s.synced || when < s.pos || when > s.pos+maxAhead.Seconds()*s.rate {
+		s.offset = s.pos + Latency.Seconds()*s.rate - t
+		s.synced = true
+	}
+	t += s.offset
+	digit := DigitTime.Seconds() * s.rate
+	for n := 0; n < 20; n++ {
+		if w&(1<<n) != 0 {
+			s.pulses = append(s.pulses, t+float64(n)*digit)
+		}
+	}
+}
+
+// SetFilter turns the loudspeaker model on or off.
+func (s *Speaker) SetFilter(on bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.filter = on
+}
+
+// Filter reports whether the loudspeaker model is on.
+func (s *Speaker) Filter() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.filter
+}
+
+// Read produces samples. It always fills as much of p as it can (a whole
+// number of samples), with silence if there are no pulses to play.
+func (s *Speaker) Read(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// The pulses within one sample are averaged: a sample full of pulses
+	// has the value 1.
+	perPulse := DigitTime.Seconds() * s.rate
+	n := len(p) / 4
+	for i := 0; i < n; i++ {
+		s.pos++
+		k := 0
+		for k < len(s.pulses) && s.pulses[k] < s.pos {
+			k++
+		}
+		x := float64(k) * perPulse
+		s.pulses = s.pulses[k:]
+
+		if s.filter {
+			s.lp += s.lowPass * (x - s.lp)
+			s.hp = s.highPass * (s.hp + s.lp - s.lpPrev)
+			s.lpPrev = s.lp
+			x = s.hp
+		}
+		v := int16(math.Max(-1, math.Min(1, x)) * math.MaxInt16)
+		binary.LittleEndian.PutUint16(p[4*i:], uint16(v))
+		binary.LittleEndian.PutUint16(p[4*i+2:], uint16(v))
+	}
+	return 4 * n, nil
+}
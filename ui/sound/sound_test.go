@@ -0,0 +1,145 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package sound
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+	"time"
+)
+
+const rate = 48000
+
+// samples reads n samples from the speaker, checks both channels match, and
+// returns the left channel scaled to [-1, 1].
+func samples(t *testing.T, s *Speaker, n int) []float64 {
+	t.Helper()
+	p := make([]byte, 4*n+3) // the extra bytes can't hold a whole sample
+	got, err := s.Read(p)
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	if got != 4*n {
+		t.Fatalf("Read() = %d, want %d", got, 4*n)
+	}
+	out := make([]float64, n)
+	for i := range out {
+		l := int16(binary.LittleEndian.Uint16(p[4*i:]))
+		r := int16(binary.LittleEndian.Uint16(p[4*i+2:]))
+		if l != r {
+			t.Fatalf("sample %d: left %d != right %d", i, l, r)
+		}
+		out[i] = float64(l) / math.MaxInt16
+	}
+	return out
+}
+
+// firstSound returns the index of the first non-zero sample, or -1.
+func firstSound(xs []float64) int {
+	for i, x := range xs {
+		if x != 0 {
+			return i
+		}
+	}
+	return -1
+}
+
+func TestSilence(t *testing.T) {
+	s := NewSpeaker(rate)
+	if i := firstSound(samples(t, s, rate/10)); i != -1 {
+		t.Errorf("speaker with no pulses made a sound at sample %d", i)
+	}
+	// A word with no 1 digits makes no pulses.
+	s.Pulse(0, 0)
+	if i := firstSound(samples(t, s, rate/10)); i != -1 {
+		t.Errorf("pulse of word 0 made a sound at sample %d", i)
+	}
+}
+
+func TestRawPulses(t *testing.T) {
+	s := NewSpeaker(rate)
+	s.SetFilter(false)
+	if s.Filter() {
+		t.Fatal("Filter() = true after SetFilter(false)")
+	}
+	s.Pulse(0, 0x55555)
+	s.Pulse(10*time.Millisecond, 0b101)
+	xs := samples(t, s, rate/10)
+
+	// The first word is played after the latency, and the second 10ms
+	// later.
+	want := int(Latency.Seconds() * rate)
+	if got := firstSound(xs); got != want {
+		t.Errorf("first sound at sample %d, want %d", got, want)
+	}
+	if got, want := firstSound(xs[want+10:]), 480-10; got != want {
+		t.Errorf("second sound %d samples after the first word, want %d", got, want)
+	}
+
+	// Raw, the sound is the pulses averaged over each sample: in total,
+	// 12 pulses.
+	sum := 0.0
+	for _, x := range xs {
+		sum += x
+	}
+	if want := 12 * DigitTime.Seconds() * rate; math.Abs(sum-want) > 0.01 {
+		t.Errorf("sum of samples = %f, want %f", sum, want)
+	}
+}
+
+func TestFilteredClick(t *testing.T) {
+	s := NewSpeaker(rate)
+	s.Pulse(0, 0xfffff)
+	xs := samples(t, s, rate/5)
+
+	start := int(Latency.Seconds() * rate)
+	peak := 0.0
+	for _, x := range xs[:start+20] {
+		peak = math.Max(peak, math.Abs(x))
+	}
+	if peak < 0.1 {
+		t.Errorf("peak of click = %f, want at least 0.1", peak)
+	}
+	// The click decays back to silence.
+	for i, x := range xs[start+rate/20:] {
+		if math.Abs(x) > 0.001 {
+			t.Fatalf("sample %d after click = %f, want silence", start+rate/20+i, x)
+		}
+	}
+}
+
+func TestResync(t *testing.T) {
+	s := NewSpeaker(rate)
+	s.SetFilter(false)
+	s.Pulse(time.Second, 1)
+	samples(t, s, rate/5)
+
+	// The machine was stopped for a while, so the next pulse would be in
+	// the past: it is played after the latency again.
+	s.Pulse(time.Second+time.Millisecond, 1)
+	if got, want := firstSound(samples(t, s, rate/5)), int(Latency.Seconds()*rate); got != want {
+		t.Errorf("pulse after pause at sample %d, want %d", got, want)
+	}
+
+	// The machine jumped far ahead, so the next pulse is also played after
+	// the latency rather than seconds later.
+	s.Pulse(time.Hour, 1)
+	if got, want := firstSound(samples(t, s, rate/5)), int(Latency.Seconds()*rate); got != want {
+		t.Errorf("pulse after jump at sample %d, want %d", got, want)
+	}
+}
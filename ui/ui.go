@@ -15,14 +15,44 @@
 */
 
 // The ui program provides a user interface to the csirac implementation.
+//
+// Usage:
+//
+//	ui [-speed n] [file ...]
+//
+// Each file is loaded into the machine according to its extension: a program
+// (assembler source, or a memory image with the extension .img) is loaded
+// into the main store, a drum image (.ma.img to .md.img) onto a drum, a
+// 12-hole tape image (.t12 raw or .h12 holes) into the tape reader, and a
+// snapshot (.snap) replaces the whole machine. The machine is operated from
+// the console at the bottom of the window, and monitored on the tubes at the
+// top. Words can also be typed on the DEPOSIT line of the console, in the form
+// "M[12] = ( 2,18, 4, 0)" or "A = -0.5" (see csirac.ParseWord). Output from the teleprinter and the tape punch appears on the right,
+// and the loudspeaker plays through the computer's speakers (see package
+// sound).
+//
+// The UI also runs in the browser. Build it with:
+//
+//	GOOS=js GOARCH=wasm go build -o ui.wasm ./ui
+//
+// and serve ui.wasm alongside ui/index.html and wasm_exec.js (from
+// $(go env GOROOT)/lib/wasm). In the browser, files are loaded with the LOAD
+// button or embedded in the page URL (see package web), and SAVE and RESTORE
+// keep a snapshot of the machine in the browser's local storage.
 package main
 
 import (
 	"embed"
-	"image/color"
+	"flag"
+	"image"
 	"image/png"
+	"log"
+	"os"
+	"time"
 
+	"github.com/DrJosh9000/CSIRAC"
 	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
 )
 
 //go:embed embed
@@ -30,27 +60,96 @@ var embeds embed.FS
 
 var (
 	crtsym = mustLoadImage("embed/crtsym.png")
+
+	speed = flag.Float64("speed", float64(time.Second/csirac.InstructionTime), "instructions per second while running (sound is slowed down or sped up to match)")
 )
 
+const screenWidth, screenHeight = 960, 600
+
 func main() {
+	flag.Parse()
+	m := newMachine(*speed)
+	for _, name := range flag.Args() {
+		data, err := os.ReadFile(name)
+		if err != nil {
+			log.Fatalf("Couldn't read file: %v", err)
+		}
+		if err := m.loadFile(name, data); err != nil {
+			log.Fatalf("Couldn't load %s: %v", name, err)
+		}
+	}
+	if err := hostInit(m); err != nil {
+		m.err = err
+	}
+
 	ebiten.SetWindowResizable(true)
-	ebiten.SetWindowSize(640, 480)
-	ebiten.SetWindowTitle("TODO")
+	ebiten.SetWindowSize(screenWidth, screenHeight)
+	ebiten.SetWindowTitle("CSIRAC")
 
-	ebiten.RunGame(csiracUI{})
+	ui := &csiracUI{
+		machine: m,
+	}
+	ui.widgets = append(ui.widgets, monitorWidgets(m, image.Pt(24, 8))...)
+	ui.widgets = append(ui.widgets, consoleWidgets(m, image.Pt(16, 450))...)
+	ui.widgets = append(ui.widgets, deviceWidgets(m, image.Pt(484, 8))...)
+	ui.widgets = append(ui.widgets, hostWidgets(m, image.Pt(560, 450))...)
+	if p, err := startAudio(m); err != nil {
+		log.Printf("Couldn't start audio: %v", err)
+	} else {
+		ui.widgets = append(ui.widgets, audioWidgets(m, p, image.Pt(560, 478))...)
+	}
+	if err := ebiten.RunGame(ui); err != nil {
+		log.Fatalf("Couldn't run UI: %v", err)
+	}
 }
 
-type csiracUI struct{}
-
-func (csiracUI) Draw(screen *ebiten.Image) {
-	screen.Fill(color.RGBA{69, 69, 69, 255})
-
-	screen.DrawImage(crtsym, nil)
+type csiracUI struct {
+	machine *machine
+	widgets []widget
 }
 
-func (csiracUI) Layout(int, int) (int, int) { return 640, 480 }
+func (u *csiracUI) Draw(screen *ebiten.Image) {
+	screen.Fill(panelColour)
+	for _, w := range u.widgets {
+		w.draw(screen)
+	}
+}
 
-func (csiracUI) Update() error { return nil }
+func (u *csiracUI) Layout(int, int) (int, int) { return screenWidth, screenHeight }
+
+func (u *csiracUI) Update() error {
+	if inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
+		p := image.Pt(ebiten.CursorPosition())
+		for _, w := range u.widgets {
+			if p.In(w.bounds()) {
+				w.click(p)
+				break
+			}
+		}
+	}
+	if _, dy := ebiten.Wheel(); dy != 0 {
+		p := image.Pt(ebiten.CursorPosition())
+		for _, w := range u.widgets {
+			if s, ok := w.(scroller); ok && p.In(w.bounds()) {
+				s.scroll(dy)
+				break
+			}
+		}
+	}
+	chars := ebiten.AppendInputChars(nil)
+	enter := inpututil.IsKeyJustPressed(ebiten.KeyEnter)
+	backspace := inpututil.IsKeyJustPressed(ebiten.KeyBackspace)
+	if len(chars) > 0 || enter || backspace {
+		for _, w := range u.widgets {
+			if t, ok := w.(typist); ok {
+				t.typed(chars, enter, backspace)
+			}
+		}
+	}
+	hostUpdate(u.machine)
+	u.machine.update()
+	return nil
+}
 
 func mustLoadImage(name string) *ebiten.Image {
 	f, err := embeds.Open(name)
@@ -0,0 +1,138 @@
+//go:build js && wasm
+// +build js,wasm
+
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package web
+
+import (
+	"errors"
+	"syscall/js"
+)
+
+// PageURL returns the URL of the current page, or "" if there isn't one (for
+// example, under Node).
+func PageURL() string {
+	loc := js.Global().Get("location")
+	if loc.IsUndefined() || loc.IsNull() {
+		return ""
+	}
+	return loc.Get("href").String()
+}
+
+// LocalStorage returns the browser's local storage, and whether it is
+// available.
+func LocalStorage() (Storage, bool) {
+	ls := js.Global().Get("localStorage")
+	if ls.IsUndefined() || ls.IsNull() {
+		return nil, false
+	}
+	return jsStorage{ls}, true
+}
+
+// jsStorage is a Storage implemented by a JavaScript object with the methods
+// of the Web Storage API.
+type jsStorage struct {
+	v js.Value
+}
+
+func (s jsStorage) GetItem(key string) (string, bool) {
+	v := s.v.Call("getItem", key)
+	if v.IsNull() || v.IsUndefined() {
+		return "", false
+	}
+	return v.String(), true
+}
+
+func (s jsStorage) SetItem(key, value string) (err error) {
+	// setItem throws if the storage is full.
+	defer func() {
+		if r := recover(); r != nil {
+			e, ok := r.(js.Error)
+			if !ok {
+				panic(r)
+			}
+			err = e
+		}
+	}()
+	s.v.Call("setItem", key, value)
+	return nil
+}
+
+// OpenFile shows a file picker accepting the given file types (in the form of
+// the accept attribute, for example ".asm,.img"). When the user chooses a
+// file, it is read and passed to done. done is not called if the user
+// cancels. Browsers only show the picker in response to user input, such as
+// a click.
+//
+// done is called from a JavaScript callback, so it must not block.
+func OpenFile(accept string, done func(File, error)) {
+	doc := js.Global().Get("document")
+	input := doc.Call("createElement", "input")
+	input.Set("type", "file")
+	input.Set("accept", accept)
+	var onChange, onCancel js.Func
+	release := func() {
+		input.Call("removeEventListener", "change", onChange)
+		input.Call("removeEventListener", "cancel", onCancel)
+		onChange.Release()
+		onCancel.Release()
+	}
+	onChange = js.FuncOf(func(js.Value, []js.Value) interface{} {
+		defer release()
+		files := input.Get("files")
+		if files.Length() == 0 {
+			return nil
+		}
+		f := files.Index(0)
+		name := f.Get("name").String()
+		readBlob(f, func(data []byte, err error) {
+			done(File{Name: name, Data: data}, err)
+		})
+		return nil
+	})
+	onCancel = js.FuncOf(func(js.Value, []js.Value) interface{} {
+		release()
+		return nil
+	})
+	input.Call("addEventListener", "change", onChange)
+	input.Call("addEventListener", "cancel", onCancel)
+	input.Call("click")
+}
+
+// readBlob reads the contents of a Blob (or File), and passes them to done.
+func readBlob(blob js.Value, done func([]byte, error)) {
+	var then, catch js.Func
+	release := func() {
+		then.Release()
+		catch.Release()
+	}
+	then = js.FuncOf(func(_ js.Value, args []js.Value) interface{} {
+		defer release()
+		buf := js.Global().Get("Uint8Array").New(args[0])
+		data := make([]byte, buf.Length())
+		js.CopyBytesToGo(data, buf)
+		done(data, nil)
+		return nil
+	})
+	catch = js.FuncOf(func(_ js.Value, args []js.Value) interface{} {
+		defer release()
+		done(nil, errors.New(args[0].Call("toString").String()))
+		return nil
+	})
+	blob.Call("arrayBuffer").Call("then", then, catch)
+}
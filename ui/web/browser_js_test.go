@@ -0,0 +1,136 @@
+//go:build js && wasm
+// +build js,wasm
+
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package web
+
+import (
+	"errors"
+	"strings"
+	"syscall/js"
+	"testing"
+	"time"
+
+	"github.com/DrJosh9000/CSIRAC"
+)
+
+// fakeStorage returns an object with the Web Storage methods, which throws
+// from setItem when a value is too long (as localStorage does when full).
+func fakeStorage() js.Value {
+	return js.Global().Call("eval", `(() => {
+		const m = new Map();
+		return {
+			getItem: k => m.has(k) ? m.get(k) : null,
+			setItem: (k, v) => {
+				if (v.length > 1000) throw new Error("quota exceeded");
+				m.set(k, String(v));
+			},
+		};
+	})()`)
+}
+
+func TestJSStorage(t *testing.T) {
+	st := jsStorage{fakeStorage()}
+	if _, ok := st.GetItem("a"); ok {
+		t.Error("GetItem(a) on empty storage = _, true, want false")
+	}
+	if err := st.SetItem("a", "hello"); err != nil {
+		t.Fatalf("SetItem(a, hello) = %v", err)
+	}
+	if got, ok := st.GetItem("a"); !ok || got != "hello" {
+		t.Errorf("GetItem(a) = %q, %t, want hello, true", got, ok)
+	}
+	if err := st.SetItem("b", strings.Repeat("x", 1001)); err == nil {
+		t.Error("SetItem(b, long value) = nil error, want error")
+	}
+
+	// A snapshot of a full main store doesn't fit, but a small one does.
+	c := &csirac.CSIRAC{M: make([]csirac.Word, 1024)}
+	if err := SaveSnapshot(st, "big", c.Snapshot()); err == nil {
+		t.Error("SaveSnapshot(big) = nil error, want error")
+	}
+	c.M = c.M[:8]
+	if err := SaveSnapshot(st, "small", c.Snapshot()); err != nil {
+		t.Errorf("SaveSnapshot(small) = %v", err)
+	}
+	if _, err := LoadSnapshot(st, "big"); !errors.Is(err, ErrNoSnapshot) {
+		t.Errorf("LoadSnapshot(big) error = %v, want ErrNoSnapshot", err)
+	}
+	if _, err := LoadSnapshot(st, "small"); err != nil {
+		t.Errorf("LoadSnapshot(small) = %v", err)
+	}
+}
+
+func TestReadBlob(t *testing.T) {
+	want := []byte{0, 1, 2, 0xfe, 0xff}
+	arr := js.Global().Get("Uint8Array").New(len(want))
+	js.CopyBytesToJS(arr, want)
+	blob := js.Global().Get("Blob").New([]interface{}{arr})
+
+	type result struct {
+		data []byte
+		err  error
+	}
+	ch := make(chan result, 1)
+	readBlob(blob, func(data []byte, err error) { ch <- result{data, err} })
+	got := <-ch
+	if got.err != nil {
+		t.Fatalf("readBlob() error = %v", got.err)
+	}
+	if string(got.data) != string(want) {
+		t.Errorf("readBlob() = %v, want %v", got.data, want)
+	}
+}
+
+// TestHeadless runs a program embedded in a URL, as the UI would, without
+// any display.
+func TestHeadless(t *testing.T) {
+	if got := PageURL(); got != "" {
+		t.Logf("PageURL() = %q (not running under Node?)", got)
+	}
+	src := `
+		h M OT
+		i M OT
+		0 PL T
+		.word 0
+	h:	.word 20
+	i:	.word 6
+	`
+	files, err := ParseURL("index.html#" + EncodeURL(File{Name: "hi.asm", Data: []byte(src)}))
+	if err != nil {
+		t.Fatalf("ParseURL() = %v", err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("ParseURL() returned %d files, want 1", len(files))
+	}
+	m, err := csirac.ParseProgram(strings.NewReader(string(files[0].Data)))
+	if err != nil {
+		t.Fatalf("ParseProgram() = %v", err)
+	}
+	tp := new(csirac.Teleprinter)
+	// Programs from URLs can't be trusted to stop.
+	c := &csirac.CSIRAC{M: m, Budget: csirac.Budget{Time: time.Second}}
+	c.Attach(tp)
+	c.K = c.M[0]
+	if err := c.Run(0, false); err != nil {
+		t.Fatalf("Run() = %v", err)
+	}
+	if got, want := tp.String(), "HI"; got != want {
+		t.Errorf("printed %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,135 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package web connects the user interface to the browser when it is built
+// for WebAssembly: files embedded in the page URL, files chosen with a file
+// picker, and snapshots kept in the browser's local storage.
+//
+// Files can be embedded in the query or fragment of the page URL as
+// name=data pairs, where the name is a file name (its extension says what kind
+// of file it is) and the data is the file contents in base64 (URL or standard
+// alphabet, with or without padding). For example:
+//
+//	index.html#hello.asm=aCBNIE9UCmkgTSBPVAo...&in.h12=fCAgIC...
+//
+// The browser-specific parts of the package are only built for js/wasm.
+// Their tests run headless under Node, using the wasm exec script that comes
+// with Go:
+//
+//	GOOS=js GOARCH=wasm go test -exec="$(go env GOROOT)/lib/wasm/go_js_wasm_exec" ./ui/web
+//
+// (Before Go 1.24, the script is in misc/wasm instead of lib/wasm.)
+package web
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/DrJosh9000/CSIRAC"
+)
+
+// ErrNoSnapshot is returned by LoadSnapshot when nothing is saved under the
+// key.
+var ErrNoSnapshot = errors.New("no snapshot saved")
+
+// File is the name and contents of a file.
+type File struct {
+	Name string
+	Data []byte
+}
+
+// ParseURL returns the files embedded in the query and fragment of a URL, in
+// the order they appear.
+func ParseURL(rawURL string) ([]File, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	var files []File
+	for _, part := range []string{u.RawQuery, u.EscapedFragment()} {
+		for _, pair := range strings.Split(part, "&") {
+			if pair == "" {
+				continue
+			}
+			k, v := pair, ""
+			if i := strings.IndexByte(pair, '='); i >= 0 {
+				k, v = pair[:i], pair[i+1:]
+			}
+			name, err := url.QueryUnescape(k)
+			if err != nil {
+				return nil, fmt.Errorf("file name %q: %w", k, err)
+			}
+			enc, err := url.QueryUnescape(v)
+			if err != nil {
+				return nil, fmt.Errorf("file %q: %w", name, err)
+			}
+			data, err := decodeBase64(enc)
+			if err != nil {
+				return nil, fmt.Errorf("file %q: %w", name, err)
+			}
+			files = append(files, File{Name: name, Data: data})
+		}
+	}
+	return files, nil
+}
+
+// decodeBase64 decodes base64 in either alphabet, with or without padding.
+func decodeBase64(s string) ([]byte, error) {
+	s = strings.TrimRight(s, "=")
+	s = strings.NewReplacer("+", "-", "/", "_", " ", "-").Replace(s)
+	return base64.RawURLEncoding.DecodeString(s)
+}
+
+// EncodeURL returns the fragment (without the leading #) that embeds the
+// files in a URL, for ParseURL.
+func EncodeURL(files ...File) string {
+	parts := make([]string, 0, len(files))
+	for _, f := range files {
+		parts = append(parts, url.QueryEscape(f.Name)+"="+base64.RawURLEncoding.EncodeToString(f.Data))
+	}
+	return strings.Join(parts, "&")
+}
+
+// Storage is a key-value store for strings, such as the browser's local
+// storage.
+type Storage interface {
+	// GetItem returns the value stored under key, and whether there is one.
+	GetItem(key string) (string, bool)
+
+	// SetItem stores a value under key. It fails if the storage is full.
+	SetItem(key, value string) error
+}
+
+// SaveSnapshot saves a snapshot in storage under key.
+func SaveSnapshot(s Storage, key string, snap *csirac.Snapshot) error {
+	var sb strings.Builder
+	if err := csirac.WriteSnapshot(&sb, snap); err != nil {
+		return err
+	}
+	return s.SetItem(key, sb.String())
+}
+
+// LoadSnapshot loads the snapshot saved in storage under key.
+func LoadSnapshot(s Storage, key string) (*csirac.Snapshot, error) {
+	v, ok := s.GetItem(key)
+	if !ok {
+		return nil, fmt.Errorf("%w as %q", ErrNoSnapshot, key)
+	}
+	return csirac.ReadSnapshot(strings.NewReader(v))
+}
@@ -0,0 +1,134 @@
+/*
+   Copyright 2022 Josh Deprez
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package web
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/DrJosh9000/CSIRAC"
+)
+
+func TestParseURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want []File
+	}{
+		{"https://example.com/", nil},
+		{"https://example.com/?", nil},
+		{
+			"https://example.com/?a.asm=aCBNIE9UCg#b.img=AAAAAA&t.h12=fA==",
+			[]File{
+				{Name: "a.asm", Data: []byte("h M OT\n")},
+				{Name: "b.img", Data: []byte{0, 0, 0, 0}},
+				{Name: "t.h12", Data: []byte("|")},
+			},
+		},
+		{
+			// Mixed alphabets, with an unescaped + (which unescapes as a
+			// space), and an escaped name.
+			"index.html#my%20prog.img=-_+%2F",
+			[]File{{Name: "my prog.img", Data: []byte{0xfb, 0xff, 0xbf}}},
+		},
+		{"#empty.asm=", []File{{Name: "empty.asm", Data: []byte{}}}},
+	}
+	for _, test := range tests {
+		got, err := ParseURL(test.url)
+		if err != nil {
+			t.Errorf("ParseURL(%q) error = %v", test.url, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("ParseURL(%q) = %q, want %q", test.url, got, test.want)
+		}
+	}
+}
+
+func TestParseURLErrors(t *testing.T) {
+	tests := []string{
+		"#a.asm=!!!",
+		"#a%zz=AAAA",
+		"#a.asm=%zz",
+		"http://[::1",
+	}
+	for _, test := range tests {
+		if _, err := ParseURL(test); err == nil {
+			t.Errorf("ParseURL(%q) = nil error, want error", test)
+		}
+	}
+}
+
+func TestEncodeURL(t *testing.T) {
+	files := []File{
+		{Name: "prog.asm", Data: []byte("0 PL T\n")},
+		{Name: "a&b=c.img", Data: []byte{1, 2, 3, 4, 5, 6, 7, 8}},
+	}
+	got, err := ParseURL("index.html#" + EncodeURL(files...))
+	if err != nil {
+		t.Fatalf("ParseURL(EncodeURL()) error = %v", err)
+	}
+	if !reflect.DeepEqual(got, files) {
+		t.Errorf("ParseURL(EncodeURL(%q)) = %q", files, got)
+	}
+}
+
+// memStorage is a Storage in memory, with limited room.
+type memStorage struct {
+	items map[string]string
+	room  int
+}
+
+func (s *memStorage) GetItem(key string) (string, bool) {
+	v, ok := s.items[key]
+	return v, ok
+}
+
+func (s *memStorage) SetItem(key, value string) error {
+	if len(value) > s.room {
+		return errors.New("storage full")
+	}
+	s.items[key] = value
+	return nil
+}
+
+func TestSnapshots(t *testing.T) {
+	st := &memStorage{items: make(map[string]string), room: 1 << 20}
+	if _, err := LoadSnapshot(st, "x"); !errors.Is(err, ErrNoSnapshot) {
+		t.Errorf("LoadSnapshot(empty) error = %v, want ErrNoSnapshot", err)
+	}
+	c := &csirac.CSIRAC{A: 42, M: []csirac.Word{1, 2, 3}, Input: &csirac.InputTape{Rows: []uint16{7}}}
+	if err := SaveSnapshot(st, "x", c.Snapshot()); err != nil {
+		t.Fatalf("SaveSnapshot() = %v", err)
+	}
+	snap, err := LoadSnapshot(st, "x")
+	if err != nil {
+		t.Fatalf("LoadSnapshot() = %v", err)
+	}
+	if !reflect.DeepEqual(snap, c.Snapshot()) {
+		t.Errorf("LoadSnapshot() = %+v, want %+v", snap, c.Snapshot())
+	}
+
+	st.room = 10
+	if err := SaveSnapshot(st, "y", c.Snapshot()); err == nil {
+		t.Error("SaveSnapshot(full storage) = nil error, want error")
+	}
+	st.items["z"] = `{"A": -1}`
+	if _, err := LoadSnapshot(st, "z"); err == nil {
+		t.Error("LoadSnapshot(bad snapshot) = nil error, want error")
+	}
+}
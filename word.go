@@ -17,9 +17,10 @@
 package csirac
 
 import (
-	"bufio"
 	"fmt"
 	"io"
+	"math/big"
+	"strconv"
 	"strings"
 )
 
@@ -76,6 +77,12 @@ func (w Word) InstructionString() string {
 	return fmt.Sprintf("%2d %2d %2s %2s", w>>15, (w>>10)&0x1f, sourceToMnemonic[w.Source()], destToMnemonic[w.Dest()])
 }
 
+// Mnemonics returns the mnemonics of the source and destination of the word
+// as an instruction (U. Melbourne symbols).
+func (w Word) Mnemonics() (src, dst string) {
+	return sourceToMnemonic[w.Source()], destToMnemonic[w.Dest()]
+}
+
 // ParseInstruction parses an instruction string.
 func ParseInstruction(k string) (Word, error) {
 	var n0, n1 int
@@ -100,6 +107,92 @@ func ParseInstruction(k string) (Word, error) {
 	return Word(n0<<15 + n1<<10 + sv<<5 + dv), nil
 }
 
+// ParseWord parses a word literal, which can be written as any of:
+//
+//	( 2,18, 4, 0)  a number train, as formatted by String
+//	42             an unsigned decimal integer, up to 2^20-1
+//	-42, +42       a signed decimal integer, from -2^19 to 2^19-1
+//	-0.375         a signed decimal fraction, from -1 up to (but not including)
+//	               1, rounded to the nearest multiple of 2^-19
+//	0b101010       binary (underscores may be used to separate digits)
+//	0o52           octal
+func ParseWord(s string) (Word, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, fmt.Errorf("empty word literal")
+	}
+
+	// Number train
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		parts := strings.Split(s[1:len(s)-1], ",")
+		if len(parts) != 4 {
+			return 0, fmt.Errorf("number train %q must have 4 numbers", s)
+		}
+		var w Word
+		for _, part := range parts {
+			n, err := strconv.ParseUint(strings.TrimSpace(part), 10, 8)
+			if err != nil || n > 31 {
+				return 0, fmt.Errorf("number train %q: number %q out of valid range [0,31]", s, strings.TrimSpace(part))
+			}
+			w = w<<5 | Word(n)
+		}
+		return w, nil
+	}
+
+	// Binary and octal
+	if len(s) > 2 && s[0] == '0' {
+		base := 0
+		switch s[1] {
+		case 'b', 'B':
+			base = 2
+		case 'o', 'O':
+			base = 8
+		}
+		if base != 0 {
+			n, err := strconv.ParseUint(strings.ReplaceAll(s[2:], "_", ""), base, 20)
+			if err != nil {
+				return 0, fmt.Errorf("invalid word literal %q: %w", s, err)
+			}
+			return Word(n), nil
+		}
+	}
+
+	// Fraction
+	if strings.Contains(s, ".") {
+		r, ok := new(big.Rat).SetString(s)
+		if !ok {
+			return 0, fmt.Errorf("invalid fraction %q", s)
+		}
+		if r.Cmp(big.NewRat(-1, 1)) < 0 || r.Cmp(big.NewRat(1, 1)) >= 0 {
+			return 0, fmt.Errorf("fraction %q out of valid range [-1,1)", s)
+		}
+		return RatWord(r), nil
+	}
+
+	// Signed and unsigned decimal
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid word literal %q", s)
+	}
+	signed := s[0] == '-' || s[0] == '+'
+	switch {
+	case signed && (n < MinInt || n > MaxInt):
+		return 0, fmt.Errorf("signed integer %q out of valid range [%d,%d]", s, MinInt, MaxInt)
+	case !signed && n > allBits:
+		return 0, fmt.Errorf("integer %q out of valid range [0,%d]", s, allBits)
+	}
+	return IntWord(int(n)), nil
+}
+
+// MustParseWord parses a word literal, or panics.
+func MustParseWord(s string) Word {
+	w, err := ParseWord(s)
+	if err != nil {
+		panic(err)
+	}
+	return w
+}
+
 // MustParseInstruction parses an instruction, or panics.
 func MustParseInstruction(k string) Word {
 	w, err := ParseInstruction(k)
@@ -110,29 +203,13 @@ func MustParseInstruction(k string) Word {
 }
 
 // ParseProgram parses a (mnemonic-form) program. Programs can include comments
-// (starting with semicolon).
+// (starting with semicolon), labels, and directives (see Assemble).
 func ParseProgram(program io.Reader) ([]Word, error) {
-	// TODO: implement offsets
-	var m []Word
-	lc := 0
-	sc := bufio.NewScanner(program)
-	for sc.Scan() {
-		lc++
-		cspl := strings.SplitN(sc.Text(), ";", 2) // trim off comment
-		code := strings.TrimSpace(cspl[0])
-		if code == "" {
-			continue
-		}
-		ins, err := ParseInstruction(code)
-		if err != nil {
-			return nil, fmt.Errorf("line %d: %w", lc, err)
-		}
-		m = append(m, ins)
-	}
-	if err := sc.Err(); err != nil {
+	p, err := Assemble(program)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return p.Words, nil
 }
 
 // MustParseProgram parses a (mnemonic form) program or panics.
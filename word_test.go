@@ -1,6 +1,10 @@
 package csirac
 
-import "testing"
+import (
+	"fmt"
+	"testing"
+	"testing/quick"
+)
 
 func TestWordString(t *testing.T) {
 	tests := []struct {
@@ -35,3 +39,96 @@ func TestWordString(t *testing.T) {
 		}
 	}
 }
+
+func TestMnemonics(t *testing.T) {
+	for s := 0; s < 32; s++ {
+		for d := 0; d < 32; d++ {
+			w := Word(s<<5 | d)
+			src, dst := w.Mnemonics()
+			if mnemonicToSource[src] != s || mnemonicToDest[dst] != d {
+				t.Errorf("%v.Mnemonics() = %q, %q; want source %d and destination %d", w, src, dst, s, d)
+			}
+		}
+	}
+}
+
+func TestParseWord(t *testing.T) {
+	tests := []struct {
+		s    string
+		want Word
+	}{
+		{s: "( 2,18, 4, 0)", want: 0b00010_10010_00100_00000},
+		{s: "(31,31,31,31)", want: allBits},
+		{s: "(0,0,0,1)", want: 1},
+		{s: "42", want: 42},
+		{s: "1048575", want: allBits},
+		{s: "+42", want: 42},
+		{s: "-1", want: allBits},
+		{s: "-524288", want: signBit},
+		{s: "0.5", want: 0b01000_00000_00000_00000},
+		{s: "-0.375", want: 0b11010_00000_00000_00000},
+		{s: "-1.0", want: signBit},
+		{s: "0.1", want: 52429},
+		{s: "0b00010_10010_00100_00000", want: 0b00010_10010_00100_00000},
+		{s: "0B11", want: 3},
+		{s: "0o17", want: 15},
+		{s: "  7 ", want: 7},
+	}
+	for _, test := range tests {
+		got, err := ParseWord(test.s)
+		if err != nil {
+			t.Errorf("ParseWord(%q) error = %v", test.s, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("ParseWord(%q) = %v, want %v", test.s, got, test.want)
+		}
+	}
+}
+
+func TestParseWordErrors(t *testing.T) {
+	tests := []string{
+		"",
+		"(1,2,3)",
+		"(1,2,3,32)",
+		"(1,2,3,-1)",
+		"(a,b,c,d)",
+		"1048576",
+		"+524288",
+		"-524289",
+		"1.0",
+		"-1.5",
+		"0.5.5",
+		"0b",
+		"0b2",
+		"0o8",
+		"0b1_0000_0000_0000_0000_0000",
+		"label",
+	}
+	for _, s := range tests {
+		if got, err := ParseWord(s); err == nil {
+			t.Errorf("ParseWord(%q) = %v, nil error, want error", s, got)
+		}
+	}
+}
+
+func TestParseWordRoundTrip(t *testing.T) {
+	formats := map[string]func(Word) string{
+		"String":     Word.String,
+		"IntString":  Word.IntString,
+		"FracString": Word.FracString,
+		"decimal":    func(w Word) string { return fmt.Sprint(uint32(w)) },
+		"binary":     func(w Word) string { return fmt.Sprintf("0b%b", uint32(w)) },
+		"octal":      func(w Word) string { return fmt.Sprintf("0o%o", uint32(w)) },
+	}
+	for name, format := range formats {
+		f := func(x uint32) bool {
+			w := Word(x) & allBits
+			got, err := ParseWord(format(w))
+			return err == nil && got == w
+		}
+		if err := quick.Check(f, nil); err != nil {
+			t.Errorf("ParseWord(%s(w)) != w: %v", name, err)
+		}
+	}
+}